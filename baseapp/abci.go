@@ -205,6 +205,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	}
 
 	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx, tx)
+	app.recordMsgs(tx, err)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed)
 	}
@@ -235,6 +236,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	if app.appHashDiagnostics != nil {
+		if err := app.appHashDiagnostics.dump(app.cms, commitID); err != nil {
+			app.logger.Error("failed to write app hash dump", "height", commitID.Version, "err", err)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -341,6 +348,18 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.appVersion),
 			}
 
+		case "msg_counts":
+			bz, err := codec.MarshalJSONIndent(codec.Cdc, app.msgCounts.list())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -349,7 +368,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be either 'simulate', 'version' or 'msg_counts', none was present",
 		),
 	)
 }
@@ -451,7 +470,7 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithHeaderTimeSource(app.headerTimeSource)
 
 	// Passes the rest of the path as an argument to the querier.
 	//
@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
@@ -84,6 +85,10 @@ type BaseApp struct { // nolint: maligned
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
+	// optional recorder of the per-store root hashes and writes of the committed
+	// blocks, enabled by the app hash diagnostics mode
+	appHashDiagnostics *appHashDiagnostics
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
@@ -95,6 +100,10 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// optional source of the header time used for time dependent state
+	// transitions, defaults to the block header time if nil
+	headerTimeSource sdk.HeaderTimeSource
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -106,6 +115,11 @@ type BaseApp struct { // nolint: maligned
 
 	// application's version string
 	appVersion string
+
+	// metrics reported on DeliverTx and the message counts served by the
+	// app/msg_counts query
+	metrics   *Metrics
+	msgCounts *msgCountRegistry
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -127,6 +141,8 @@ func NewBaseApp(
 		queryRouter:    NewQueryRouter(),
 		txDecoder:      txDecoder,
 		fauxMerkleMode: false,
+		metrics:        NopMetrics(),
+		msgCounts:      newMsgCountRegistry(),
 	}
 	for _, option := range options {
 		option(app)
@@ -203,12 +219,26 @@ func (app *BaseApp) MountTransientStores(keys map[string]*sdk.TransientStoreKey)
 // multistore, using a specified DB.
 func (app *BaseApp) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db dbm.DB) {
 	app.cms.MountStoreWithDB(key, typ, db)
+	app.listenAppHashDiagnostics(key, typ)
 }
 
 // MountStore mounts a store to the provided key in the BaseApp multistore,
 // using the default DB.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+	app.listenAppHashDiagnostics(key, typ)
+}
+
+// listenAppHashDiagnostics adds the app hash diagnostics recorder as a listener
+// of a mounted store if the app hash diagnostics mode is enabled. Transient
+// stores are not committed to by the app hash.
+func (app *BaseApp) listenAppHashDiagnostics(key sdk.StoreKey, typ sdk.StoreType) {
+	if app.appHashDiagnostics == nil || typ == sdk.StoreTypeTransient {
+		return
+	}
+
+	app.appHashDiagnostics.keys = append(app.appHashDiagnostics.keys, key)
+	app.cms.AddListeners(key, []sdk.WriteListener{app.appHashDiagnostics})
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -342,6 +372,10 @@ func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setHeaderTimeSource(ts sdk.HeaderTimeSource) {
+	app.headerTimeSource = ts
+}
+
 func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
@@ -354,6 +388,14 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setAppHashDiagnostics(dir string, keepRecent int64) {
+	if keepRecent < 1 {
+		panic(fmt.Sprintf("invalid number of recent app hash dumps to keep: %d", keepRecent))
+	}
+
+	app.appHashDiagnostics = newAppHashDiagnostics(dir, keepRecent)
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -380,8 +422,10 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, true, app.logger).
+			WithMinGasPrices(app.minGasPrices).
+			WithHeaderTimeSource(app.headerTimeSource),
 	}
 }
 
@@ -393,7 +437,7 @@ func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.deliverState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger),
+		ctx: sdk.NewContext(ms, header, false, app.logger).WithHeaderTimeSource(app.headerTimeSource),
 	}
 }
 
@@ -472,12 +516,33 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 	return app.checkState
 }
 
+// execMode returns the execution mode exposed to the Context for a runTxMode.
+func (mode runTxMode) execMode() sdk.ExecMode {
+	switch mode {
+	case runTxModeReCheck:
+		return sdk.ExecModeReCheck
+	case runTxModeSimulate:
+		return sdk.ExecModeSimulate
+	case runTxModeDeliver:
+		return sdk.ExecModeDeliver
+	default:
+		return sdk.ExecModeCheck
+	}
+}
+
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context {
+	txHash := tmhash.Sum(txBytes)
+
 	ctx := app.getState(mode).ctx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos).
-		WithConsensusParams(app.consensusParams)
+		WithConsensusParams(app.consensusParams).
+		WithExecMode(mode.execMode()).
+		WithTxHash(txHash)
+
+	// expose the tx hash to all loggers derived from the tx context, e.g. keeper loggers
+	ctx = ctx.WithLogger(ctx.Logger().With("tx_hash", fmt.Sprintf("%X", txHash)))
 
 	if mode == runTxModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
@@ -562,6 +627,25 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
 	}()
 
+	// consumeBlockGas consumes the gas used by the tx from the block gas meter
+	// once. It is called before the state changes of the messages are written,
+	// so that they are discarded if the block gas limit is exceeded.
+	var blockGasConsumed bool
+	consumeBlockGas := func() {
+		if blockGasConsumed {
+			return
+		}
+		blockGasConsumed = true
+
+		ctx.BlockGasMeter().ConsumeGas(
+			ctx.GasMeter().GasConsumedToLimit(), "block gas meter",
+		)
+
+		if ctx.BlockGasMeter().GasConsumed() < startingGas {
+			panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
+		}
+	}
+
 	// If BlockGasMeter() panics it will be caught by the above recover and will
 	// return an error - in any case BlockGasMeter will consume gas past the limit.
 	//
@@ -569,13 +653,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// to recover from this one.
 	defer func() {
 		if mode == runTxModeDeliver {
-			ctx.BlockGasMeter().ConsumeGas(
-				ctx.GasMeter().GasConsumedToLimit(), "block gas meter",
-			)
-
-			if ctx.BlockGasMeter().GasConsumed() < startingGas {
-				panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
-			}
+			consumeBlockGas()
 		}
 	}()
 
@@ -617,6 +695,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 			return gInfo, nil, err
 		}
 
+		// Check the gas limit of the tx against the remaining block gas, so that
+		// a tx which could exceed the block gas limit is rejected before any of
+		// its state changes are written. Nothing is deducted from the block gas
+		// meter until the messages have run. A proposer which respects the block
+		// gas limit never includes such a tx.
+		if remaining := ctx.BlockGasRemaining(); mode == runTxModeDeliver && gasWanted > remaining {
+			return gInfo, nil, sdkerrors.Wrapf(
+				sdkerrors.ErrOutOfGas, "tx gas limit %d exceeds the remaining block gas %d", gasWanted, remaining,
+			)
+		}
+
 		msCache.Write()
 	}
 
@@ -630,9 +719,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
 	if err == nil && mode == runTxModeDeliver {
+		// panics and discards the state changes if the block gas limit is exceeded
+		consumeBlockGas()
 		msCache.Write()
 	}
 
+	// report the priority of the tx set by the AnteHandler to the mempool
+	if priority, ok := ctx.TxPriority(); ok && result != nil && (mode == runTxModeCheck || mode == runTxModeReCheck) {
+		result.Events = result.Events.AppendEvent(
+			sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(priority, 10))),
+		)
+	}
+
 	return gInfo, result, err
 }
 
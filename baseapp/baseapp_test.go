@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/kv"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
@@ -806,6 +810,91 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// Test that the tx context carries the execution mode and the tx hash
+func TestTxContextValues(t *testing.T) {
+	var (
+		modes  []sdk.ExecMode
+		hashes [][]byte
+	)
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			mode, ok := ctx.ExecMode()
+			require.True(t, ok)
+			modes = append(modes, mode)
+			hashes = append(hashes, ctx.TxHash())
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck}).IsOK())
+	_, _, err = app.Simulate(txBytes, tx)
+	require.NoError(t, err)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	require.Equal(t, []sdk.ExecMode{sdk.ExecModeCheck, sdk.ExecModeReCheck, sdk.ExecModeSimulate, sdk.ExecModeDeliver}, modes)
+	for _, hash := range hashes {
+		require.Equal(t, tmhash.Sum(txBytes), hash)
+	}
+}
+
+// Test that the priority set by the AnteHandler is reported in CheckTx
+func TestCheckTxPriority(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithTxPriority(tx.(txTest).Counter), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(7, 0))
+	require.NoError(t, err)
+
+	priorityEvent := abci.Event{
+		Type:       sdk.EventTypeTx,
+		Attributes: []kv.Pair{{Key: []byte(sdk.AttributeKeyPriority), Value: []byte("7")}},
+	}
+	for _, typ := range []abci.CheckTxType{abci.CheckTxType_New, abci.CheckTxType_Recheck} {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: typ})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		require.Equal(t, []abci.Event{priorityEvent}, res.Events)
+	}
+
+	// the priority is only reported to the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.NotContains(t, res.Events, priorityEvent)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -849,6 +938,46 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+func TestDeliverTxMsgCounts(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	failedTx := newTxCounter(2, 3)
+	failedTx.setFailOnAnte(true)
+
+	for _, tx := range []*txTest{newTxCounter(0, 0, 1), newTxCounter(1, 2), failedTx} {
+		txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+		app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	res := app.Query(abci.RequestQuery{Path: "app/msg_counts"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var counts []MsgCount
+	require.NoError(t, codec.UnmarshalJSON(res.Value, &counts))
+
+	msgType := MsgType(msgCounter{})
+	require.Equal(t, []MsgCount{
+		{MsgType: msgType, Codespace: "", Code: 0, Count: 3},
+		{MsgType: msgType, Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrUnauthorized.ABCICode(), Count: 1},
+	}, counts)
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1283,6 +1412,55 @@ func TestMaxBlockGasLimits(t *testing.T) {
 	}
 }
 
+func TestBlockGasLimitCheck(t *testing.T) {
+	gasGranted := uint64(10)
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(gasGranted))
+			newCtx.GasMeter().ConsumeGas(uint64(tx.(*txTest).Counter), "counter-ante")
+			return
+		})
+	}
+
+	var remaining []uint64
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			remaining = append(remaining, ctx.BlockGasRemaining())
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{
+				MaxGas: 15,
+			},
+		},
+	})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the gas limits of the first two txs fit into the remaining block gas, which
+	// is only charged with the gas they used once their messages have run
+	for i := 0; i < 2; i++ {
+		_, _, err := app.Deliver(newTxCounter(5, 0))
+		require.NoError(t, err)
+	}
+	require.Equal(t, []uint64{15, 10}, remaining)
+
+	// the gas limit of the third tx exceeds the remaining block gas, so its
+	// messages are not run even though the gas it uses would fit
+	_, result, err := app.Deliver(newTxCounter(5, 0))
+	require.Nil(t, result)
+	require.True(t, sdkerrors.ErrOutOfGas.Is(err), err)
+	require.Len(t, remaining, 2)
+
+	ctx := app.getState(runTxModeDeliver).ctx
+	require.Equal(t, uint64(0), ctx.BlockGasRemaining())
+}
+
 func TestBaseAppAnteHandler(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *BaseApp) {
@@ -1590,3 +1768,90 @@ func TestWithRouter(t *testing.T) {
 		app.Commit()
 	}
 }
+
+func TestAppHashDiagnostics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apphash")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	beginBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			store := ctx.KVStore(capKey2)
+			store.Set([]byte("height"), []byte(fmt.Sprintf("%d", req.Header.Height)))
+			store.Delete([]byte(fmt.Sprintf("height/%d", req.Header.Height-1)))
+			store.Set([]byte(fmt.Sprintf("height/%d", req.Header.Height)), []byte{0x01})
+			return abci.ResponseBeginBlock{}
+		})
+	}
+	require.Panics(t, func() { setupBaseApp(t, SetAppHashDiagnostics(dir, 0)) })
+
+	// dumps left behind by a previous run are pruned as well, other files are kept
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "0.json"), []byte("{}"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte{}, 0644))
+
+	app := setupBaseApp(t, SetAppHashDiagnostics(dir, 2), beginBlockerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	var res abci.ResponseCommit
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		res = app.Commit()
+	}
+
+	// only the dumps of the recent blocks are kept
+	for _, name := range []string{"0.json", "1.json"} {
+		_, err = LoadAppHashDump(filepath.Join(dir, name))
+		require.True(t, os.IsNotExist(err), name)
+	}
+	_, err = os.Stat(filepath.Join(dir, "notes.txt"))
+	require.NoError(t, err)
+	_, err = LoadAppHashDump(filepath.Join(dir, "2.json"))
+	require.NoError(t, err)
+
+	dump, err := LoadAppHashDump(filepath.Join(dir, "3.json"))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), dump.Height)
+	require.Equal(t, res.Data, []byte(dump.AppHash))
+	require.Len(t, dump.StoreHashes, 2)
+	require.Equal(t, app.cms.GetCommitKVStore(capKey2).LastCommitID().Hash, []byte(dump.StoreHashes[capKey2.Name()]))
+	require.Equal(t, []StoreWrite{
+		{Store: capKey2.Name(), Key: []byte("height"), Value: []byte("3")},
+		{Store: capKey2.Name(), Key: []byte("height/2"), Delete: true},
+		{Store: capKey2.Name(), Key: []byte("height/3"), Value: []byte{0x01}},
+	}, dump.Writes)
+
+	diff, err := DiffAppHashDumps(dump, dump)
+	require.NoError(t, err)
+	require.True(t, diff.AppHash)
+	require.Empty(t, diff.Stores)
+
+	// a reference node which wrote a different value and did not delete a key
+	ref := dump
+	ref.AppHash = []byte("reference")
+	ref.StoreHashes = map[string]tmbytes.HexBytes{
+		capKey1.Name(): dump.StoreHashes[capKey1.Name()],
+		capKey2.Name(): []byte("reference"),
+	}
+	ref.Writes = []StoreWrite{
+		{Store: capKey2.Name(), Key: []byte("height"), Value: []byte("4")},
+		{Store: capKey2.Name(), Key: []byte("height/3"), Value: []byte{0x01}},
+	}
+
+	diff, err = DiffAppHashDumps(dump, ref)
+	require.NoError(t, err)
+	require.False(t, diff.AppHash)
+	require.Equal(t, []StoreDiff{{
+		Store:   capKey2.Name(),
+		Hash:    dump.StoreHashes[capKey2.Name()],
+		RefHash: []byte("reference"),
+		Keys: []KeyDiff{
+			{Key: []byte("height"), Write: &dump.Writes[0], RefWrite: &ref.Writes[0]},
+			{Key: []byte("height/2"), Write: &dump.Writes[1]},
+		},
+	}}, diff.Stores)
+
+	ref.Height = 4
+	_, err = DiffAppHashDumps(dump, ref)
+	require.Error(t, err)
+}
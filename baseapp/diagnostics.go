@@ -0,0 +1,274 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppHashDump records the state transition of a committed block, so that the
+// cause of an app hash mismatch can be found by diffing the dump of the
+// offending block against the dump of a reference node with DiffAppHashDumps.
+// StoreHashes are the root hashes of the stores committed to by the app hash,
+// and Writes the final writes of the block to the stores, ordered by store and
+// key.
+type AppHashDump struct {
+	Height      int64                       `json:"height"`
+	AppHash     tmbytes.HexBytes            `json:"app_hash"`
+	StoreHashes map[string]tmbytes.HexBytes `json:"store_hashes"`
+	Writes      []StoreWrite                `json:"writes"`
+}
+
+// StoreWrite is a write of a block to a store. The value of a deleted key is
+// nil.
+type StoreWrite struct {
+	Store  string           `json:"store"`
+	Key    tmbytes.HexBytes `json:"key"`
+	Value  tmbytes.HexBytes `json:"value,omitempty"`
+	Delete bool             `json:"delete,omitempty"`
+}
+
+// AppHashDiff is the difference between the dumps of a block of two nodes. It
+// lists the stores whose root hashes differ.
+type AppHashDiff struct {
+	Height  int64       `json:"height"`
+	AppHash bool        `json:"app_hash_match"`
+	Stores  []StoreDiff `json:"stores"`
+}
+
+// StoreDiff is the difference between the writes of a block to a store of two
+// nodes. It lists the keys which were written differently, where a nil write
+// means that the key was not written by the node.
+type StoreDiff struct {
+	Store   string           `json:"store"`
+	Hash    tmbytes.HexBytes `json:"hash"`
+	RefHash tmbytes.HexBytes `json:"reference_hash"`
+	Keys    []KeyDiff        `json:"keys"`
+}
+
+// KeyDiff is the difference between the writes of a block to a key of two nodes.
+type KeyDiff struct {
+	Key      tmbytes.HexBytes `json:"key"`
+	Write    *StoreWrite      `json:"write"`
+	RefWrite *StoreWrite      `json:"reference_write"`
+}
+
+// LoadAppHashDump reads an AppHashDump from a file written in app hash
+// diagnostics mode.
+func LoadAppHashDump(path string) (AppHashDump, error) {
+	var dump AppHashDump
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return dump, err
+	}
+
+	if err := json.Unmarshal(bz, &dump); err != nil {
+		return dump, fmt.Errorf("failed to unmarshal app hash dump %s: %w", path, err)
+	}
+
+	return dump, nil
+}
+
+// DiffAppHashDumps returns the difference between the dump of a block and the
+// dump of the same block of a reference node.
+func DiffAppHashDumps(dump, ref AppHashDump) (AppHashDiff, error) {
+	if dump.Height != ref.Height {
+		return AppHashDiff{}, fmt.Errorf("cannot diff dumps of different heights: %d, %d", dump.Height, ref.Height)
+	}
+
+	diff := AppHashDiff{
+		Height:  dump.Height,
+		AppHash: bytes.Equal(dump.AppHash, ref.AppHash),
+		Stores:  []StoreDiff{},
+	}
+
+	names := make(map[string]bool)
+	for name := range dump.StoreHashes {
+		names[name] = true
+	}
+	for name := range ref.StoreHashes {
+		names[name] = true
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	writes, refWrites := groupWrites(dump.Writes), groupWrites(ref.Writes)
+	for _, name := range sorted {
+		hash, refHash := dump.StoreHashes[name], ref.StoreHashes[name]
+		if hash != nil && bytes.Equal(hash, refHash) {
+			continue
+		}
+
+		diff.Stores = append(diff.Stores, StoreDiff{
+			Store:   name,
+			Hash:    hash,
+			RefHash: refHash,
+			Keys:    diffWrites(writes[name], refWrites[name]),
+		})
+	}
+
+	return diff, nil
+}
+
+// groupWrites returns the writes by store and key.
+func groupWrites(writes []StoreWrite) map[string]map[string]StoreWrite {
+	res := make(map[string]map[string]StoreWrite)
+	for _, w := range writes {
+		if res[w.Store] == nil {
+			res[w.Store] = make(map[string]StoreWrite)
+		}
+		res[w.Store][string(w.Key)] = w
+	}
+
+	return res
+}
+
+// diffWrites returns the keys written differently, ordered by key.
+func diffWrites(writes, refWrites map[string]StoreWrite) []KeyDiff {
+	keys := make(map[string]bool)
+	for key := range writes {
+		keys[key] = true
+	}
+	for key := range refWrites {
+		keys[key] = true
+	}
+
+	diffs := []KeyDiff{}
+	for key := range keys {
+		w, found := writes[key]
+		refW, refFound := refWrites[key]
+		if found && refFound && w.Delete == refW.Delete && bytes.Equal(w.Value, refW.Value) {
+			continue
+		}
+
+		diff := KeyDiff{Key: []byte(key)}
+		if found {
+			diff.Write = &w
+		}
+		if refFound {
+			diff.RefWrite = &refW
+		}
+		diffs = append(diffs, diff)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return bytes.Compare(diffs[i].Key, diffs[j].Key) < 0 })
+	return diffs
+}
+
+// appHashDiagnostics collects the writes of a block to the committed stores,
+// and writes an AppHashDump to its directory after the block is committed. The
+// dumps of the blocks before the keepRecent most recent blocks are removed.
+type appHashDiagnostics struct {
+	dir        string
+	keepRecent int64
+	keys       []sdk.StoreKey
+	writes     map[string]StoreWrite
+}
+
+var _ sdk.WriteListener = (*appHashDiagnostics)(nil)
+
+func newAppHashDiagnostics(dir string, keepRecent int64) *appHashDiagnostics {
+	return &appHashDiagnostics{
+		dir:        dir,
+		keepRecent: keepRecent,
+		writes:     make(map[string]StoreWrite),
+	}
+}
+
+// OnWrite implements sdk.WriteListener. It records the last write to each key.
+func (d *appHashDiagnostics) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	w := StoreWrite{
+		Store:  storeKey.Name(),
+		Key:    append([]byte{}, key...),
+		Value:  append([]byte(nil), value...),
+		Delete: delete,
+	}
+	d.writes[fmt.Sprintf("%s/%X", w.Store, key)] = w
+
+	return nil
+}
+
+// dump writes the AppHashDump of the block committed to the multi-store with
+// the given commit id, and clears the writes collected.
+func (d *appHashDiagnostics) dump(cms sdk.CommitMultiStore, commitID sdk.CommitID) error {
+	dump := AppHashDump{
+		Height:      commitID.Version,
+		AppHash:     commitID.Hash,
+		StoreHashes: make(map[string]tmbytes.HexBytes, len(d.keys)),
+		Writes:      make([]StoreWrite, 0, len(d.writes)),
+	}
+
+	for _, key := range d.keys {
+		dump.StoreHashes[key.Name()] = cms.GetCommitKVStore(key).LastCommitID().Hash
+	}
+
+	for _, w := range d.writes {
+		dump.Writes = append(dump.Writes, w)
+	}
+	sort.Slice(dump.Writes, func(i, j int) bool {
+		if dump.Writes[i].Store != dump.Writes[j].Store {
+			return dump.Writes[i].Store < dump.Writes[j].Store
+		}
+		return bytes.Compare(dump.Writes[i].Key, dump.Writes[j].Key) < 0
+	})
+	d.writes = make(map[string]StoreWrite)
+
+	bz, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(d.path(dump.Height), bz, 0644); err != nil {
+		return err
+	}
+
+	return d.prune(dump.Height - d.keepRecent)
+}
+
+// prune removes the dumps of the blocks up to the given height, including the
+// dumps left behind by a previous run with a larger window.
+func (d *appHashDiagnostics) prune(height int64) error {
+	files, err := ioutil.ReadDir(d.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+
+		h, err := strconv.ParseInt(strings.TrimSuffix(f.Name(), ".json"), 10, 64)
+		if err != nil || h > height {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(d.dir, f.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *appHashDiagnostics) path(height int64) string {
+	return filepath.Join(d.dir, fmt.Sprintf("%d.json", height))
+}
@@ -0,0 +1,146 @@
+package baseapp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "baseapp"
+
+	// labels of the message counter
+	msgTypeLabel   = "msg_type"
+	resultLabel    = "result"
+	codespaceLabel = "codespace"
+	codeLabel      = "code"
+)
+
+// Metrics contains metrics exposed by the BaseApp.
+type Metrics struct {
+	// Number of messages executed in DeliverTx, labeled by message type and
+	// result of the transaction.
+	Msgs metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+//
+// NOTE: The metrics are registered with the default Prometheus registry, and
+// as such, must be built at most once per process.
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	return &Metrics{
+		Msgs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "msgs",
+			Help:      "Number of messages executed in DeliverTx, by message type and result.",
+		}, append(labels, msgTypeLabel, resultLabel, codespaceLabel, codeLabel)).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Msgs: discard.NewCounter(),
+	}
+}
+
+// MsgCount defines the number of messages of a type which have been executed
+// in DeliverTx with the given result. A zero code means success.
+type MsgCount struct {
+	MsgType   string `json:"msg_type" yaml:"msg_type"`
+	Codespace string `json:"codespace" yaml:"codespace"`
+	Code      uint32 `json:"code" yaml:"code"`
+	Count     uint64 `json:"count" yaml:"count"`
+}
+
+type msgCountKey struct {
+	msgType   string
+	codespace string
+	code      uint32
+}
+
+// msgCountRegistry counts the messages executed since the application has been
+// started, serving the app/msg_counts query.
+type msgCountRegistry struct {
+	mtx    sync.Mutex
+	counts map[msgCountKey]uint64
+}
+
+func newMsgCountRegistry() *msgCountRegistry {
+	return &msgCountRegistry{counts: make(map[msgCountKey]uint64)}
+}
+
+func (c *msgCountRegistry) add(key msgCountKey) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.counts[key]++
+}
+
+// list returns the counts sorted by message type and result.
+func (c *msgCountRegistry) list() []MsgCount {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	counts := make([]MsgCount, 0, len(c.counts))
+	for key, count := range c.counts {
+		counts = append(counts, MsgCount{MsgType: key.msgType, Codespace: key.codespace, Code: key.code, Count: count})
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].MsgType != counts[j].MsgType {
+			return counts[i].MsgType < counts[j].MsgType
+		}
+		if counts[i].Codespace != counts[j].Codespace {
+			return counts[i].Codespace < counts[j].Codespace
+		}
+		return counts[i].Code < counts[j].Code
+	})
+
+	return counts
+}
+
+// MsgType returns the type of a message reported by the message counters, i.e.
+// its route and type.
+func MsgType(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// recordMsgs counts the messages of a transaction executed in DeliverTx with
+// the result of the transaction.
+func (app *BaseApp) recordMsgs(tx sdk.Tx, err error) {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+
+	result := "success"
+	if code != 0 {
+		result = "failure"
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgType := MsgType(msg)
+
+		app.metrics.Msgs.With(
+			msgTypeLabel, msgType, resultLabel, result, codespaceLabel, codespace, codeLabel, strconv.FormatUint(uint64(code), 10),
+		).Add(1)
+		app.msgCounts.add(msgCountKey{msgType: msgType, codespace: codespace, code: code})
+	}
+}
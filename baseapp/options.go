@@ -28,6 +28,13 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetHeaderTimeSource returns a BaseApp option function that sets the source of
+// the time returned by Context.HeaderTime. It is meant for tests which need to
+// fast-forward time, the source must be deterministic across all nodes.
+func SetHeaderTimeSource(ts sdk.HeaderTimeSource) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHeaderTimeSource(ts) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
@@ -38,6 +45,17 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// SetAppHashDiagnostics returns a BaseApp option function that enables the app
+// hash diagnostics mode. In this mode, the per-store root hashes and the writes
+// of each committed block are written as an AppHashDump to the given directory,
+// keeping the dumps of the keepRecent most recent blocks, which must be at least
+// one. When the node halts on
+// an app hash mismatch, the dump of the offending block is diffed against the
+// dump of a reference node with DiffAppHashDumps.
+func SetAppHashDiagnostics(dir string, keepRecent int64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setAppHashDiagnostics(dir, keepRecent) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
@@ -136,6 +154,14 @@ func (app *BaseApp) SetStoreLoader(loader StoreLoader) {
 	app.storeLoader = loader
 }
 
+// SetMetrics sets the metrics the BaseApp reports to.
+func (app *BaseApp) SetMetrics(m *Metrics) {
+	if app.sealed {
+		panic("SetMetrics() on sealed BaseApp")
+	}
+	app.metrics = m
+}
+
 // SetRouter allows us to customize the router.
 func (app *BaseApp) SetRouter(router sdk.Router) {
 	if app.sealed {
@@ -3,6 +3,7 @@ package debug
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,13 +13,17 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
-func Cmd(cdc *codec.Codec) *cobra.Command {
+// Cmd returns the debug commands. The store keys of the modules of the given
+// BasicManager can be pretty-printed with the store-key command.
+func Cmd(cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "debug",
 		Short: "Tool for helping with debugging your application",
@@ -28,6 +33,9 @@ func Cmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(PubkeyCmd(cdc))
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(DecodeTxCmd(cdc))
+	cmd.AddCommand(StoreKeyCmd(mbm))
+	cmd.AddCommand(AppHashDiffCmd())
 
 	return cmd
 }
@@ -123,7 +131,8 @@ func AddrCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "addr [address]",
 		Short: "Convert an address between hex and bech32",
-		Long: fmt.Sprintf(`Convert an address between hex encoding and bech32.
+		Long: fmt.Sprintf(`Convert an address between hex encoding and bech32. Account, validator
+operator and validator consensus bech32 addresses are accepted.
 			
 Example:
 $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
@@ -145,19 +154,28 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 					addr, err3 = sdk.ValAddressFromBech32(addrString)
 
 					if err3 != nil {
-						return fmt.Errorf("expected hex or bech32. Got errors: hex: %v, bech32 acc: %v, bech32 val: %v", err, err2, err3)
+						var err4 error
+						addr, err4 = sdk.ConsAddressFromBech32(addrString)
 
+						if err4 != nil {
+							return fmt.Errorf(
+								"expected hex or bech32. Got errors: hex: %v, bech32 acc: %v, bech32 val: %v, bech32 cons: %v",
+								err, err2, err3, err4,
+							)
+						}
 					}
 				}
 			}
 
 			accAddr := sdk.AccAddress(addr)
 			valAddr := sdk.ValAddress(addr)
+			consAddr := sdk.ConsAddress(addr)
 
 			cmd.Println("Address:", addr)
 			cmd.Printf("Address (hex): %X\n", addr)
 			cmd.Printf("Bech32 Acc: %s\n", accAddr)
 			cmd.Printf("Bech32 Val: %s\n", valAddr)
+			cmd.Printf("Bech32 Cons: %s\n", consAddr)
 			return nil
 		},
 	}
@@ -192,3 +210,123 @@ $ %s debug raw-bytes [72 101 108 108 111 44 32 112 108 97 121 103 114 111 117 11
 		},
 	}
 }
+
+// decodeBytes decodes a string from hex, or base64 if it is not valid hex.
+func decodeBytes(str string) ([]byte, error) {
+	bz, err := hex.DecodeString(str)
+	if err == nil {
+		return bz, nil
+	}
+
+	bz, err2 := base64.StdEncoding.DecodeString(str)
+	if err2 != nil {
+		return nil, fmt.Errorf("expected hex or base64. Got errors: hex: %v, base64: %v", err, err2)
+	}
+
+	return bz, nil
+}
+
+func DecodeTxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-tx [tx]",
+		Short: "Decode an amino-encoded transaction from hex or base64",
+		Long: fmt.Sprintf(`Decode an amino-encoded transaction, e.g. as returned by the Tendermint RPC,
+from hex or base64 and print it as JSON. Both length-prefixed and bare encodings are accepted.
+
+Example:
+$ %s debug decode-tx 2AHwYl3uCj...
+			`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := decodeBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var tx sdk.Tx
+			if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &tx); err != nil {
+				if err2 := cdc.UnmarshalBinaryBare(bz, &tx); err2 != nil {
+					return fmt.Errorf("failed to decode tx: %v", err2)
+				}
+			}
+
+			out, err := cdc.MarshalJSONIndent(tx, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+}
+
+// StoreKeyCmd returns a command which pretty-prints a raw store key of any of
+// the given modules implementing module.HasStoreKeyDecoder.
+func StoreKeyCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "store-key [module] [key]",
+		Short: "Pretty-print a raw store key of a module from hex or base64",
+		Long: fmt.Sprintf(`Pretty-print a raw store key of a module, e.g. as reported when diagnosing
+a consensus failure, from hex or base64.
+
+Example:
+$ %s debug store-key staking 31BF0A9C0A06EB4541B48BE0FC3970C2BA4E02C8D30564F4B2FA43294EE88DDE0BBFF2F8658C2D4F62
+			`, version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := decodeBytes(args[1])
+			if err != nil {
+				return err
+			}
+
+			out, err := mbm.DecodeStoreKey(args[0], key)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(out)
+			return nil
+		},
+	}
+}
+
+func AppHashDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "apphash-diff [dump] [reference-dump]",
+		Short: "Diff the app hash dump of a block against the dump of a reference node",
+		Long: fmt.Sprintf(`Diff the app hash dump of a block, written by a node started with
+--apphash-diagnostics-dir, against the dump of the same block written by a reference node.
+The stores whose root hashes differ are printed as JSON, along with the keys written
+differently by the two nodes in the block.
+
+Example:
+$ %s debug apphash-diff ~/.app/apphash/1234.json ./reference/1234.json
+			`, version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dump, err := baseapp.LoadAppHashDump(args[0])
+			if err != nil {
+				return err
+			}
+
+			ref, err := baseapp.LoadAppHashDump(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff, err := baseapp.DiffAppHashDumps(dump, ref)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(diff, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"fmt"
 
 	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
 	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/ethsecp256k1"
 )
 
 // Cdc defines a global generic sealed Amino codec to be used throughout sdk. It
@@ -34,6 +37,21 @@ func New() *Codec {
 // codec.
 func RegisterCrypto(cdc *Codec) {
 	cryptoamino.RegisterAmino(cdc)
+	ethsecp256k1.RegisterCodec(cdc)
+}
+
+// PubKeyFromBytes decodes an amino encoded public key of any of the key types
+// registered by RegisterCrypto.
+func PubKeyFromBytes(bz []byte) (pubKey crypto.PubKey, err error) {
+	err = Cdc.UnmarshalBinaryBare(bz, &pubKey)
+	return pubKey, err
+}
+
+// PrivKeyFromBytes decodes an amino encoded private key of any of the key types
+// registered by RegisterCrypto.
+func PrivKeyFromBytes(bz []byte) (privKey crypto.PrivKey, err error) {
+	err = Cdc.UnmarshalBinaryBare(bz, &privKey)
+	return privKey, err
 }
 
 // RegisterEvidences registers Tendermint evidence types with the provided Amino
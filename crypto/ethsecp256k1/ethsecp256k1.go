@@ -0,0 +1,197 @@
+// Package ethsecp256k1 implements secp256k1 keys compatible with Ethereum
+// wallets. Addresses are derived from the Keccak-256 hash of the uncompressed
+// public key and messages are signed as EIP-191 personal messages, using the
+// recoverable R || S || V signature format of personal_sign.
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/btcsuite/btcd/btcec"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/sha3"
+)
+
+// Amino names and sizes of the key types and signatures
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeyEthSecp256k1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeyEthSecp256k1"
+
+	PrivKeySize   = 32
+	PubKeySize    = 33
+	SignatureSize = 65
+)
+
+// used to reject malleable signatures, as done by Ethereum
+var secp256k1HalfN = new(big.Int).Rsh(btcec.S256().N, 1)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterCodec(cdc)
+}
+
+// RegisterCodec registers the key types on the given codec, which must have the
+// crypto.PubKey and crypto.PrivKey interfaces registered.
+func RegisterCodec(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeyEthSecp256k1{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeyEthSecp256k1{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeyEthSecp256k1{}
+
+// PrivKeyEthSecp256k1 implements crypto.PrivKey.
+type PrivKeyEthSecp256k1 [PrivKeySize]byte
+
+// GenPrivKey generates a new private key using OS randomness.
+func GenPrivKey() PrivKeyEthSecp256k1 {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	return PrivKeyFromSecret(priv.Serialize())
+}
+
+// PrivKeyFromSecret returns the private key with the given 32 byte scalar, e.g.
+// a BIP32 derived key.
+func PrivKeyFromSecret(secret []byte) PrivKeyEthSecp256k1 {
+	var privKey PrivKeyEthSecp256k1
+	copy(privKey[PrivKeySize-len(secret):], secret)
+	return privKey
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeyEthSecp256k1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// PubKey returns the compressed public key of the private key.
+func (privKey PrivKeyEthSecp256k1) PubKey() crypto.PubKey {
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), privKey[:])
+
+	var pubKey PubKeyEthSecp256k1
+	copy(pubKey[:], pub.SerializeCompressed())
+	return pubKey
+}
+
+// Equals runs in constant time based on the length of the keys.
+func (privKey PrivKeyEthSecp256k1) Equals(other crypto.PrivKey) bool {
+	if otherEth, ok := other.(PrivKeyEthSecp256k1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherEth[:]) == 1
+	}
+	return false
+}
+
+// Sign signs the EIP-191 personal message hash of msg. The returned signature is
+// of the form R || S || V, with S in lower form and V either 27 or 28.
+func (privKey PrivKeyEthSecp256k1) Sign(msg []byte) ([]byte, error) {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey[:])
+
+	// the compact signature is of the form V || R || S
+	sig, err := btcec.SignCompact(btcec.S256(), priv, PersonalMessageHash(msg), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sig[1:], sig[0]), nil
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeyEthSecp256k1{}
+
+// PubKeyEthSecp256k1 implements crypto.PubKey. It is the compressed form of
+// the public key.
+type PubKeyEthSecp256k1 [PubKeySize]byte
+
+// Address returns the Ethereum address of the public key, i.e. the last 20
+// bytes of the Keccak-256 hash of the uncompressed public key. As any 33 bytes
+// may be decoded as public key, nil is returned if the key is not a point on
+// the curve, which matches no account.
+func (pubKey PubKeyEthSecp256k1) Address() crypto.Address {
+	pub, err := btcec.ParsePubKey(pubKey[:], btcec.S256())
+	if err != nil {
+		return nil
+	}
+
+	// skip the 0x04 prefix of the uncompressed form
+	return crypto.Address(Keccak256(pub.SerializeUncompressed()[1:])[12:])
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeyEthSecp256k1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a signature of the form R || S || V created by Sign or
+// by an Ethereum wallet signing msg as personal message. V may be either 0 or 1,
+// or 27 or 28. Signatures which are not in lower-S form are rejected.
+func (pubKey PubKeyEthSecp256k1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	v := sig[SignatureSize-1]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return false
+	}
+
+	if new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) > 0 {
+		return false
+	}
+
+	compactSig := append([]byte{27 + v}, sig[:64]...)
+	pub, _, err := btcec.RecoverCompact(btcec.S256(), compactSig, PersonalMessageHash(msg))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(pub.SerializeCompressed(), pubKey[:])
+}
+
+// String implements the Stringer interface.
+func (pubKey PubKeyEthSecp256k1) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey[:])
+}
+
+// Equals returns whether both public keys are equal.
+func (pubKey PubKeyEthSecp256k1) Equals(other crypto.PubKey) bool {
+	if otherEth, ok := other.(PubKeyEthSecp256k1); ok {
+		return bytes.Equal(pubKey[:], otherEth[:])
+	}
+	return false
+}
+
+//-------------------------------------
+
+// Keccak256 returns the legacy Keccak-256 hash of the data, as used by
+// Ethereum.
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz) // nolint: errcheck
+	}
+
+	return hasher.Sum(nil)
+}
+
+// PersonalMessageHash returns the hash of msg signed by Ethereum wallets for
+// personal messages as defined by EIP-191, i.e. the Keccak-256 hash of
+// "\x19Ethereum Signed Message:\n" || len(msg) || msg.
+func PersonalMessageHash(msg []byte) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(msg))
+	return Keccak256([]byte(prefix), msg)
+}
@@ -0,0 +1,104 @@
+package ethsecp256k1_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/ethsecp256k1"
+)
+
+func TestAddress(t *testing.T) {
+	// key and address of the EIP-155 example transaction
+	secret, err := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+
+	privKey := ethsecp256k1.PrivKeyFromSecret(secret)
+	require.Equal(t, "9D8A62F656A8D1615C1294FD71E9CFB3E4855A4F", privKey.PubKey().Address().String())
+
+	// keys which are not on the curve have no address
+	var invalid ethsecp256k1.PubKeyEthSecp256k1
+	invalid[0] = 0x02
+	require.Nil(t, invalid.Address())
+	require.False(t, invalid.VerifyBytes([]byte("hello"), make([]byte, ethsecp256k1.SignatureSize)))
+}
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte(`{"account_number":"1","chain_id":"test","sequence":"0"}`)
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.Contains(t, []byte{27, 28}, sig[64])
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// signatures are deterministic
+	sig2, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Equal(t, sig, sig2)
+
+	// the recovery id may be given without offset
+	sigNoOffset := append(append([]byte{}, sig[:64]...), sig[64]-27)
+	require.True(t, pubKey.VerifyBytes(msg, sigNoOffset))
+
+	require.False(t, pubKey.VerifyBytes([]byte("other message"), sig))
+	require.False(t, ethsecp256k1.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes(msg, sig[:64]))
+
+	invalidV := append(append([]byte{}, sig[:64]...), 29)
+	require.False(t, pubKey.VerifyBytes(msg, invalidV))
+
+	// the signature is over the personal message hash, not the message itself
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey[:])
+	rawSig, err := btcec.SignCompact(btcec.S256(), priv, ethsecp256k1.Keccak256(msg), false)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifyBytes(msg, append(rawSig[1:], rawSig[0])))
+}
+
+func TestVerifyRejectsMalleableSignature(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	msg := []byte("hello")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	// (R, N - S) with the flipped recovery id is an equally valid ECDSA signature
+	n := btcec.S256().N
+	s := new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:64]))
+
+	malleable := make([]byte, ethsecp256k1.SignatureSize)
+	copy(malleable[:32], sig[:32])
+	sBytes := s.Bytes()
+	copy(malleable[64-len(sBytes):64], sBytes)
+	malleable[64] = 27 + (1 - (sig[64] - 27))
+
+	require.False(t, privKey.PubKey().VerifyBytes(msg, malleable))
+}
+
+func TestAminoEncoding(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	resPrivKey, err := codec.PrivKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(resPrivKey))
+
+	resPubKey, err := codec.PubKeyFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(resPubKey))
+
+	// keys of the same secret differ from tendermint secp256k1 keys
+	var tmPrivKey secp256k1.PrivKeySecp256k1
+	copy(tmPrivKey[:], privKey[:])
+	require.True(t, bytes.Equal(tmPrivKey.PubKey().Bytes()[5:], pubKey.Bytes()[5:]))
+	require.False(t, pubKey.Equals(tmPrivKey.PubKey()))
+	require.NotEqual(t, tmPrivKey.PubKey().Address(), pubKey.Address())
+}
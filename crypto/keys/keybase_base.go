@@ -11,6 +11,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
 	"github.com/cosmos/cosmos-sdk/types"
 )
@@ -77,7 +78,7 @@ func newBaseKeybase(optionsFns ...KeybaseOption) baseKeybase {
 	options := kbOptions{
 		keygenFunc:           StdPrivKeyGen,
 		deriveFunc:           StdDeriveKey,
-		supportedAlgos:       []SigningAlgo{Secp256k1},
+		supportedAlgos:       []SigningAlgo{Secp256k1, EthSecp256k1},
 		supportedAlgosLedger: []SigningAlgo{Secp256k1},
 	}
 
@@ -89,12 +90,16 @@ func newBaseKeybase(optionsFns ...KeybaseOption) baseKeybase {
 }
 
 // StdPrivKeyGen is the default PrivKeyGen function in the keybase.
-// For now, it only supports Secp256k1
+// For now, it only supports Secp256k1 and EthSecp256k1
 func StdPrivKeyGen(bz []byte, algo SigningAlgo) (tmcrypto.PrivKey, error) {
-	if algo == Secp256k1 {
+	switch algo {
+	case Secp256k1:
 		return SecpPrivKeyGen(bz), nil
+	case EthSecp256k1:
+		return ethsecp256k1.PrivKeyFromSecret(bz), nil
+	default:
+		return nil, ErrUnsupportedSigningAlgo
 	}
-	return nil, ErrUnsupportedSigningAlgo
 }
 
 // SecpPrivKeyGen generates a secp256k1 private key from the given bytes
@@ -249,9 +254,10 @@ func (kb baseKeybase) writeMultisigKey(w infoWriter, name string, pub tmcrypto.P
 }
 
 // StdDeriveKey is the default DeriveKey function in the keybase.
-// For now, it only supports Secp256k1
+// For now, it only supports Secp256k1 and EthSecp256k1, which both use BIP32
+// derivation on the secp256k1 curve
 func StdDeriveKey(mnemonic string, bip39Passphrase, hdPath string, algo SigningAlgo) ([]byte, error) {
-	if algo == Secp256k1 {
+	if algo == Secp256k1 || algo == EthSecp256k1 {
 		return SecpDeriveKey(mnemonic, bip39Passphrase, hdPath)
 	}
 	return nil, ErrUnsupportedSigningAlgo
@@ -435,6 +435,30 @@ func TestSeedPhrase(t *testing.T) {
 	require.Equal(t, info.GetPubKey(), newInfo.GetPubKey())
 }
 
+// TestEthSecp256k1Account verifies that Ethereum wallet keys can be recovered
+func TestEthSecp256k1Account(t *testing.T) {
+	cstore := NewInMemory()
+	require.True(t, IsSupportedAlgorithm(cstore.SupportedAlgos(), EthSecp256k1))
+
+	// first account of the mnemonic commonly used by Ethereum development tools
+	mnemonic := "test test test test test test test test test test test junk"
+	info, err := cstore.CreateAccount("eth", mnemonic, DefaultBIP39Passphrase, nums, "44'/60'/0'/0/0", EthSecp256k1)
+	require.NoError(t, err)
+	require.Equal(t, EthSecp256k1, info.GetAlgo())
+	require.Equal(t, "F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266", info.GetPubKey().Address().String())
+
+	msg := []byte("hello")
+	sig, pub, err := cstore.Sign("eth", nums, msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the same derivation yields a different secp256k1 address
+	info, err = cstore.CreateAccount("cosmos", mnemonic, DefaultBIP39Passphrase, nums, "44'/60'/0'/0/0", Secp256k1)
+	require.NoError(t, err)
+	require.NotEqual(t, "F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266", info.GetPubKey().Address().String())
+}
+
 func ExampleNew() {
 	// Select the encryption and storage for your cryptostore
 	customKeyGenFunc := func(bz []byte, algo SigningAlgo) (crypto.PrivKey, error) {
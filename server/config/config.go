@@ -10,6 +10,10 @@ import (
 
 const (
 	defaultMinGasPrices = ""
+
+	// DefaultTelemetryNamespace is the default namespace metrics are exposed
+	// under.
+	DefaultTelemetryNamespace = "cosmos"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -38,9 +42,22 @@ type BaseConfig struct {
 	Pruning string `mapstructure:"pruning"`
 }
 
+// TelemetryConfig defines the application's telemetry configuration
+type TelemetryConfig struct {
+	// Enabled enables the application's Prometheus metrics. Metrics are served
+	// alongside Tendermint's through its instrumentation listen address, which
+	// requires Prometheus to be enabled in the Tendermint configuration too.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Namespace is the namespace all application metrics are exposed under.
+	Namespace string `mapstructure:"namespace"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	Telemetry TelemetryConfig `mapstructure:"telemetry"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -78,5 +95,9 @@ func DefaultConfig() *Config {
 			InterBlockCache: true,
 			Pruning:         store.PruningStrategySyncable,
 		},
+		TelemetryConfig{
+			Enabled:   false,
+			Namespace: DefaultTelemetryNamespace,
+		},
 	}
 }
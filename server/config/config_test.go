@@ -11,6 +11,8 @@ import (
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.GetMinGasPrices().IsZero())
+	require.False(t, cfg.Telemetry.Enabled)
+	require.Equal(t, DefaultTelemetryNamespace, cfg.Telemetry.Namespace)
 }
 
 func TestSetMinimumFees(t *testing.T) {
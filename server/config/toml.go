@@ -39,6 +39,17 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state
 pruning = "{{ .BaseConfig.Pruning }}"
+
+##### telemetry configuration options #####
+[telemetry]
+
+# Enabled enables the application's Prometheus metrics. Metrics are served
+# alongside Tendermint's through its instrumentation listen address, which
+# requires Prometheus to be enabled in the Tendermint configuration too.
+enabled = {{ .Telemetry.Enabled }}
+
+# Namespace is the namespace all application metrics are exposed under.
+namespace = "{{ .Telemetry.Namespace }}"
 `
 
 var configTemplate *template.Template
@@ -30,6 +30,9 @@ const (
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
+
+	FlagAppHashDiagnosticsDir        = "apphash-diagnostics-dir"
+	FlagAppHashDiagnosticsKeepRecent = "apphash-diagnostics-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -82,6 +85,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().String(
+		FlagAppHashDiagnosticsDir, "",
+		"Write the per-store root hashes and the writes of each committed block to the given directory, to be diffed against a reference node on app hash mismatch",
+	)
+	cmd.Flags().Int64(FlagAppHashDiagnosticsKeepRecent, 100, "Number of recent blocks whose app hash dumps are kept (at least 1)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 
 	// add support for all Tendermint-specific command line options
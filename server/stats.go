@@ -0,0 +1,83 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+const flagLargestKeys = "largest-keys"
+
+// StoreStatsCmd reports the key count and size of every module store at a
+// given height by walking the application database. The node must not be
+// running.
+func StoreStatsCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-stats",
+		Short: "Report per-module store key counts and sizes",
+		Long: `Report the number of keys, the total key and value bytes, and the largest
+key/value pairs of every module store at a given height by walking the application
+database. The node must be stopped while running this command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height := viper.GetInt64(flagHeight)
+			if height < 0 {
+				height = 0
+			}
+
+			stats, err := rootmulti.GetStoreStats(db, height, viper.GetInt(flagLargestKeys))
+			if err != nil {
+				return fmt.Errorf("error reading store stats: %v", err)
+			}
+
+			out := cmd.OutOrStdout()
+			w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "STORE\tKEYS\tKEY BYTES\tVALUE BYTES\tTOTAL BYTES")
+
+			var keys, total int64
+			for _, s := range stats {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", s.Name, s.Keys, s.KeyBytes, s.ValueBytes, s.TotalBytes())
+				keys += s.Keys
+				total += s.TotalBytes()
+			}
+
+			fmt.Fprintf(w, "total\t%d\t\t\t%d\n", keys, total)
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			for _, s := range stats {
+				if len(s.LargestKeys) == 0 {
+					continue
+				}
+
+				fmt.Fprintf(out, "\nlargest keys of %s:\n", s.Name)
+				for _, k := range s.LargestKeys {
+					fmt.Fprintf(out, "  %X (%d bytes)\n", k.Key, k.Size)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, -1, "Report the stores at a particular height (-1 means latest height)")
+	cmd.Flags().Int(flagLargestKeys, 5, "Number of largest key/value pairs to report per store")
+	return cmd
+}
@@ -142,6 +142,7 @@ func AddCommands(
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		StoreStatsCmd(ctx),
 		flags.LineBreak,
 		version.Cmd,
 	)
@@ -0,0 +1,36 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// Metrics defines the metrics a SimApp reports to.
+type Metrics struct {
+	BaseApp *baseapp.Metrics
+	Staking *staking.Metrics
+}
+
+// NewMetrics returns the metrics as configured by the [telemetry] section of
+// the application configuration. Prometheus metrics are registered globally,
+// so they must be built once and shared by every application instance in the
+// process.
+func NewMetrics(cfg config.TelemetryConfig) Metrics {
+	if !cfg.Enabled {
+		return NopMetrics()
+	}
+
+	return Metrics{
+		BaseApp: baseapp.PrometheusMetrics(cfg.Namespace),
+		Staking: staking.PrometheusMetrics(cfg.Namespace),
+	}
+}
+
+// NopMetrics returns metrics which are not reported.
+func NopMetrics() Metrics {
+	return Metrics{
+		BaseApp: baseapp.NopMetrics(),
+		Staking: staking.NopMetrics(),
+	}
+}
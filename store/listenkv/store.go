@@ -0,0 +1,91 @@
+package listenkv
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = (*Store)(nil)
+
+// Store implements the KVStore interface with listening enabled. The writes to
+// the store are reported to the WriteListeners, in the order in which they are
+// added, before they are delegated to the parent KVStore.
+type Store struct {
+	parent    types.KVStore
+	listeners []types.WriteListener
+	parentKey types.StoreKey
+}
+
+// NewStore returns a reference to a new listenkv Store given a parent KVStore
+// implementation, the key of the parent store and the listeners.
+func NewStore(parent types.KVStore, parentKey types.StoreKey, listeners []types.WriteListener) *Store {
+	return &Store{parent: parent, listeners: listeners, parentKey: parentKey}
+}
+
+// Get implements the KVStore interface. It delegates the Get call to the parent
+// KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It reports the write to the listeners
+// and delegates the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	s.onWrite(key, value, false)
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It reports the deletion to the
+// listeners and delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.onWrite(key, nil, true)
+	s.parent.Delete(key)
+}
+
+// Has implements the KVStore interface. It delegates the Has call to the
+// parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call to
+// the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. The writes of the cache are
+// reported to the listeners when it is written.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface. The writes of the cache
+// are reported to the listeners when it is written.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *Store) onWrite(key, value []byte, delete bool) {
+	for _, l := range s.listeners {
+		if err := l.OnWrite(s.parentKey, key, value, delete); err != nil {
+			panic(fmt.Sprintf("failed to report write to %s store: %v", s.parentKey.Name(), err))
+		}
+	}
+}
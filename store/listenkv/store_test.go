@@ -0,0 +1,59 @@
+package listenkv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+type write struct {
+	storeKey types.StoreKey
+	key      string
+	value    string
+	delete   bool
+}
+
+type recordListener struct {
+	writes []write
+}
+
+func (l *recordListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.writes = append(l.writes, write{storeKey, string(key), string(value), delete})
+	return nil
+}
+
+func TestListenKVStore(t *testing.T) {
+	key := types.NewKVStoreKey("test")
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	l1, l2 := &recordListener{}, &recordListener{}
+	store := listenkv.NewStore(parent, key, []types.WriteListener{l1, l2})
+
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+	store.Delete([]byte("a"))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.False(t, parent.Has([]byte("a")))
+
+	expected := []write{
+		{key, "a", "1", false},
+		{key, "b", "2", false},
+		{key, "a", "", true},
+	}
+	require.Equal(t, expected, l1.writes)
+	require.Equal(t, expected, l2.writes)
+
+	// the writes of a cache are reported when it is written
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("c"), []byte("3"))
+	require.Len(t, l1.writes, 3)
+
+	cache.Write()
+	require.Equal(t, write{key, "c", "3", false}, l1.writes[3])
+	require.Equal(t, []byte("3"), parent.Get([]byte("c")))
+}
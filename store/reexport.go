@@ -27,6 +27,7 @@ type (
 	StoreType        = types.StoreType
 	Queryable        = types.Queryable
 	TraceContext     = types.TraceContext
+	WriteListener    = types.WriteListener
 	Gas              = stypes.Gas
 	GasMeter         = types.GasMeter
 	GasConfig        = stypes.GasConfig
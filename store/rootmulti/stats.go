@@ -0,0 +1,99 @@
+package rootmulti
+
+import (
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// KeyStats contains the size of a single key/value pair in a store.
+type KeyStats struct {
+	Key  []byte `json:"key"`
+	Size int64  `json:"size"`
+}
+
+// StoreStats contains the key count and size statistics of a single committed
+// substore.
+type StoreStats struct {
+	Name        string     `json:"name"`
+	Keys        int64      `json:"keys"`
+	KeyBytes    int64      `json:"key_bytes"`
+	ValueBytes  int64      `json:"value_bytes"`
+	LargestKeys []KeyStats `json:"largest_keys"`
+}
+
+// TotalBytes returns the total size of the keys and values of the store.
+func (s StoreStats) TotalBytes() int64 {
+	return s.KeyBytes + s.ValueBytes
+}
+
+// GetStoreStats walks all substores committed to db at the given version, or
+// the latest version if version is zero, and returns their statistics sorted by
+// store name. Each entry reports up to numLargest of the store's largest
+// key/value pairs. The db is only read from.
+func GetStoreStats(db dbm.DB, version int64, numLargest int) ([]StoreStats, error) {
+	if version == 0 {
+		version = getLatestVersion(db)
+	}
+
+	cInfo, err := getCommitInfo(db, version)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]StoreStats, 0, len(cInfo.StoreInfos))
+
+	for _, storeInfo := range cInfo.StoreInfos {
+		prefixDB := dbm.NewPrefixDB(db, []byte("s/k:"+storeInfo.Name+"/"))
+
+		store, err := iavl.LoadStore(prefixDB, storeInfo.Core.CommitID, types.PruneNothing, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load store %s at version %d: %v", storeInfo.Name, version, err)
+		}
+
+		stats = append(stats, getKVStoreStats(storeInfo.Name, store, numLargest))
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
+
+// getKVStoreStats iterates over all key/value pairs of a store.
+func getKVStoreStats(name string, store types.KVStore, numLargest int) StoreStats {
+	stats := StoreStats{Name: name, LargestKeys: []KeyStats{}}
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+
+		stats.Keys++
+		stats.KeyBytes += int64(len(key))
+		stats.ValueBytes += int64(len(value))
+
+		size := int64(len(key) + len(value))
+		if numLargest <= 0 {
+			continue
+		}
+		if len(stats.LargestKeys) == numLargest && stats.LargestKeys[numLargest-1].Size >= size {
+			continue
+		}
+
+		// insert the pair, keeping the largest pairs sorted by descending size
+		i := sort.Search(len(stats.LargestKeys), func(i int) bool { return stats.LargestKeys[i].Size < size })
+		stats.LargestKeys = append(stats.LargestKeys, KeyStats{})
+		copy(stats.LargestKeys[i+1:], stats.LargestKeys[i:])
+		stats.LargestKeys[i] = KeyStats{Key: key, Size: size}
+
+		if len(stats.LargestKeys) > numLargest {
+			stats.LargestKeys = stats.LargestKeys[:numLargest]
+		}
+	}
+
+	return stats
+}
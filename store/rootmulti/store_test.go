@@ -89,6 +89,44 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestGetStoreStats(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store1 := ms.getStoreByName("store1").(types.KVStore)
+	store1.Set([]byte("a"), []byte("1"))
+	store1.Set([]byte("bb"), []byte("22"))
+	store1.Set([]byte("ccc"), []byte("333"))
+	ms.Commit()
+
+	store1.Delete([]byte("a"))
+	ms.getStoreByName("store2").(types.KVStore).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+
+	// stats of a past version
+	stats, err := GetStoreStats(db, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, stats, 3)
+	require.Equal(t, "store1", stats[0].Name)
+	require.Equal(t, int64(3), stats[0].Keys)
+	require.Equal(t, int64(6), stats[0].KeyBytes)
+	require.Equal(t, int64(12), stats[0].TotalBytes())
+	require.Equal(t, []KeyStats{{Key: []byte("ccc"), Size: 6}, {Key: []byte("bb"), Size: 4}}, stats[0].LargestKeys)
+	require.Equal(t, int64(0), stats[1].Keys)
+
+	// stats of the latest version
+	stats, err = GetStoreStats(db, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats[0].Keys)
+	require.Equal(t, "store2", stats[1].Name)
+	require.Equal(t, int64(1), stats[1].Keys)
+	require.Equal(t, int64(8), stats[1].TotalBytes())
+
+	_, err = GetStoreStats(db, 3, 2)
+	require.Error(t, err)
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -372,6 +410,38 @@ func TestMultiStoreRestart(t *testing.T) {
 	require.Equal(t, []byte(fmt.Sprintf("%s:%d", v3, 2)), val3, "Reloaded value not the same as last flushed value")
 }
 
+type storeKeysListener struct {
+	writes []string
+}
+
+func (l *storeKeysListener) OnWrite(storeKey types.StoreKey, key []byte, _ []byte, _ bool) error {
+	l.writes = append(l.writes, fmt.Sprintf("%s/%s", storeKey.Name(), key))
+	return nil
+}
+
+func TestMultiStoreListeners(t *testing.T) {
+	multi := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1 := multi.keysByName["store1"]
+	listener := &storeKeysListener{}
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(multi.keysByName["store2"]))
+
+	multi.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	multi.GetKVStore(multi.keysByName["store2"]).Set([]byte("b"), []byte("2"))
+	require.Equal(t, []string{"store1/a"}, listener.writes)
+
+	// the writes of a cache-wrapped multi-store are reported when it is written
+	cms := multi.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("c"), []byte("3"))
+	require.Len(t, listener.writes, 1)
+
+	cms.Write()
+	require.Equal(t, []string{"store1/a", "store1/c"}, listener.writes)
+}
+
 func TestMultiStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -0,0 +1,9 @@
+package types
+
+// WriteListener is notified of the writes to a KVStore which it is added to.
+type WriteListener interface {
+	// OnWrite is called with the key of the store, the key and value written
+	// and whether the key was deleted, in which case the value is nil. An
+	// error aborts the write.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
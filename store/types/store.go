@@ -155,6 +155,15 @@ type CommitMultiStore interface {
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// AddListeners adds WriteListeners for the KVStore of the given key, which
+	// are notified of the writes to the store, including those of cache-wrapped
+	// stores when they are written.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore of the
+	// given key.
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
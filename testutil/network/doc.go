@@ -0,0 +1,29 @@
+/*
+Package network implements and exposes a fully operational in-process Tendermint
+test network that consists of at least one or potentially many validators. This
+test network can be used primarily for integration tests or unit test suites.
+
+The test network utilizes SimApp as the ABCI application and uses all the modules
+defined in SimApp. Each validator is bonded through a genesis transaction and
+runs its own Tendermint node. Only the first validator exposes the Tendermint
+RPC together with a REST server, as the Tendermint RPC relies on package-level
+state which cannot be shared by several nodes within a single process.
+
+A typical testing flow might look like the following:
+
+	func TestMyModule(t *testing.T) {
+		n := network.New(t, network.DefaultConfig())
+		defer n.Cleanup()
+
+		val := n.Validators[0]
+
+		// query or broadcast through val.ClientCtx, or make REST
+		// calls against val.APIAddress
+		_, err := n.WaitForHeight(3)
+		require.NoError(t, err)
+	}
+
+Only one test network may run at a time, New blocks until the previous network
+has been cleaned up.
+*/
+package network
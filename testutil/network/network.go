@@ -0,0 +1,416 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	tmflags "github.com/tendermint/tendermint/libs/cli/flags"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/node"
+	tmclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// package-wide network lock to only allow one test network at a time
+var lock = new(sync.Mutex)
+
+// AppConstructor defines a function which accepts a network configuration and
+// creates an ABCI Application to provide to Tendermint.
+type AppConstructor = func(val Validator) abci.Application
+
+// NewSimApp is the default AppConstructor and returns a SimApp which uses the
+// minimum gas prices and the header time source of the validator's
+// configuration.
+func NewSimApp(val Validator) abci.Application {
+	return simapp.NewSimApp(
+		val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), 0, simapp.NopMetrics(),
+		bam.SetMinGasPrices(val.MinGasPrices),
+		bam.SetHeaderTimeSource(val.TimeSource),
+	)
+}
+
+// Config defines the necessary configuration used to bootstrap and start an
+// in-process local testing network.
+type Config struct {
+	Codec          *codec.Codec
+	AppConstructor AppConstructor                        // the ABCI application constructor
+	GenesisState   map[string]json.RawMessage            // custom genesis state to provide
+	RegisterRoutes func(context.CLIContext, *mux.Router) // registers the module REST routes
+	TimeoutCommit  time.Duration                         // the consensus commitment timeout
+	ChainID        string                                // the network chain-id
+	NumValidators  int                                   // the total number of validators to create and bond
+	BondDenom      string                                // the staking bond denomination
+	MinGasPrices   string                                // the minimum gas prices each validator will accept
+	AccountTokens  sdk.Int                               // the amount of unique validator tokens (e.g. 1000node0)
+	StakingTokens  sdk.Int                               // the amount of tokens each validator has available to stake
+	BondedTokens   sdk.Int                               // the amount of tokens each validator stakes
+	EnableLogging  bool                                  // enable Tendermint logging to STDOUT
+	CleanupDir     bool                                  // remove base temporary directory during cleanup
+	SigningAlgo    keys.SigningAlgo                      // signing algorithm for the validator keys
+}
+
+// DefaultConfig returns a sane default configuration suitable for nearly all
+// testing requirements.
+func DefaultConfig() Config {
+	return Config{
+		Codec:          simapp.MakeCodec(),
+		AppConstructor: NewSimApp,
+		GenesisState:   simapp.ModuleBasics.DefaultGenesis(),
+		RegisterRoutes: simapp.ModuleBasics.RegisterRESTRoutes,
+		TimeoutCommit:  2 * time.Second,
+		ChainID:        "chain-" + tmrand.Str(6),
+		NumValidators:  1,
+		BondDenom:      sdk.DefaultBondDenom,
+		MinGasPrices:   fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
+		AccountTokens:  sdk.TokensFromConsensusPower(1000),
+		StakingTokens:  sdk.TokensFromConsensusPower(500),
+		BondedTokens:   sdk.TokensFromConsensusPower(100),
+		CleanupDir:     true,
+		SigningAlgo:    keys.Secp256k1,
+	}
+}
+
+type (
+	// Network defines a local in-process testing network using SimApp. It can
+	// be configured to start any number of validators, each with its own
+	// Tendermint node. The first validator additionally exposes the Tendermint
+	// RPC and the REST API so that CLI and client tests can run against a live
+	// node instead of mocking query contexts.
+	//
+	// Note, due to the Tendermint RPC relying on package-level state, only the
+	// first validator starts an RPC server and thus a REST server and client.
+	// A caller should always call Cleanup once done with the network.
+	Network struct {
+		T          *testing.T
+		BaseDir    string
+		Validators []*Validator
+		TimeSource *TimeSource
+
+		Config
+	}
+
+	// Validator defines an in-process Tendermint validator node. Through this
+	// object, a client can make RPC and REST calls and broadcast transactions
+	// through ClientCtx.
+	Validator struct {
+		ClientCtx    context.CLIContext
+		Ctx          *server.Context
+		Dir          string
+		NodeID       string
+		PubKey       crypto.PubKey
+		Moniker      string
+		MinGasPrices string
+		APIAddress   string
+		RPCAddress   string
+		P2PAddress   string
+		Address      sdk.AccAddress
+		ValAddress   sdk.ValAddress
+		RPCClient    tmclient.Client
+		TimeSource   *TimeSource // shared by all validators, see Network.FastForward
+
+		tmNode *node.Node
+		api    net.Listener
+	}
+)
+
+// New creates and starts a new test network. It returns once the genesis
+// block has been committed.
+func New(t *testing.T, cfg Config) *Network {
+	// only one caller/test can create and use a network at a time
+	t.Log("acquiring test network lock")
+	lock.Lock()
+
+	baseDir, err := ioutil.TempDir("", cfg.ChainID)
+	require.NoError(t, err)
+	t.Logf("created temporary directory: %s", baseDir)
+
+	network := &Network{
+		T:          t,
+		BaseDir:    baseDir,
+		Validators: make([]*Validator, cfg.NumValidators),
+		TimeSource: NewTimeSource(),
+		Config:     cfg,
+	}
+
+	t.Log("preparing test network...")
+
+	var (
+		genAccounts []authexported.GenesisAccount
+		genBalances []bank.Balance
+		genTxs      []auth.StdTx
+		genFiles    []string
+		peers       []string
+	)
+
+	// generate private keys, node IDs, and initial transactions
+	for i := 0; i < cfg.NumValidators; i++ {
+		tmCfg := tmcfg.DefaultConfig()
+		tmCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
+		tmCfg.Instrumentation.Prometheus = false
+		tmCfg.P2P.AddrBookStrict = false
+		tmCfg.P2P.AllowDuplicateIP = true
+		tmCfg.TxIndex.IndexAllKeys = true
+
+		nodeDirName := fmt.Sprintf("node%d", i)
+		nodeDir := filepath.Join(baseDir, nodeDirName, "simd")
+		clientDir := filepath.Join(baseDir, nodeDirName, "simcli")
+
+		require.NoError(t, os.MkdirAll(filepath.Join(nodeDir, "config"), 0755))
+		require.NoError(t, os.MkdirAll(clientDir, 0755))
+
+		tmCfg.SetRoot(nodeDir)
+		tmCfg.Moniker = nodeDirName
+
+		var apiAddr, rpcAddr string
+
+		// only the first validator exposes an RPC and REST server
+		if i == 0 {
+			apiAddr, _, err = server.FreeTCPAddr()
+			require.NoError(t, err)
+
+			rpcAddr, _, err = server.FreeTCPAddr()
+			require.NoError(t, err)
+		}
+
+		tmCfg.RPC.ListenAddress = rpcAddr
+
+		p2pAddr, p2pPort, err := server.FreeTCPAddr()
+		require.NoError(t, err)
+		tmCfg.P2P.ListenAddress = p2pAddr
+
+		nodeID, pubKey, err := genutil.InitializeNodeValidatorFiles(tmCfg)
+		require.NoError(t, err)
+
+		genFiles = append(genFiles, tmCfg.GenesisFile())
+		peers = append(peers, fmt.Sprintf("%s@127.0.0.1:%s", nodeID, p2pPort))
+
+		kb := keys.NewInMemory(keys.WithSupportedAlgos([]keys.SigningAlgo{cfg.SigningAlgo}))
+		info, _, err := kb.CreateMnemonic(nodeDirName, keys.English, clientkeys.DefaultKeyPass, cfg.SigningAlgo)
+		require.NoError(t, err)
+
+		addr := info.GetAddress()
+		balances := sdk.NewCoins(
+			sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), cfg.AccountTokens),
+			sdk.NewCoin(cfg.BondDenom, cfg.StakingTokens),
+		)
+
+		genAccounts = append(genAccounts, auth.NewBaseAccount(addr, nil, 0, 0))
+		genBalances = append(genBalances, bank.Balance{Address: addr, Coins: balances})
+
+		createValMsg := staking.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			pubKey,
+			sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
+			staking.NewDescription(nodeDirName, "", "", "", ""),
+			staking.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			sdk.OneInt(),
+		)
+
+		memo := fmt.Sprintf("%s@127.0.0.1:%s", nodeID, p2pPort)
+		txBldr := auth.NewTxBuilder(
+			auth.DefaultTxEncoder(cfg.Codec), 0, 0, flags.DefaultGasLimit, 0, false, cfg.ChainID, memo, nil, nil,
+		).WithKeybase(kb)
+
+		tx := auth.NewStdTx([]sdk.Msg{createValMsg}, auth.NewStdFee(flags.DefaultGasLimit, nil), nil, memo)
+		signedTx, err := txBldr.SignStdTx(nodeDirName, clientkeys.DefaultKeyPass, tx, false)
+		require.NoError(t, err)
+
+		genTxs = append(genTxs, signedTx)
+
+		logger := log.NewNopLogger()
+		if cfg.EnableLogging {
+			logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+			logger, _ = tmflags.ParseLogLevel("info", logger, tmcfg.DefaultLogLevel())
+		}
+
+		clientCtx := context.CLIContext{
+			Keybase:       kb,
+			OutputFormat:  "json",
+			HomeDir:       clientDir,
+			BroadcastMode: "block",
+			SkipConfirm:   true,
+		}.
+			WithCodec(cfg.Codec).
+			WithChainID(cfg.ChainID).
+			WithTrustNode(true).
+			WithFromName(nodeDirName).
+			WithFromAddress(addr)
+
+		network.Validators[i] = &Validator{
+			ClientCtx:    clientCtx,
+			Ctx:          server.NewContext(tmCfg, logger),
+			Dir:          filepath.Join(baseDir, nodeDirName),
+			NodeID:       nodeID,
+			PubKey:       pubKey,
+			Moniker:      nodeDirName,
+			MinGasPrices: cfg.MinGasPrices,
+			RPCAddress:   rpcAddr,
+			P2PAddress:   p2pAddr,
+			APIAddress:   apiAddr,
+			Address:      addr,
+			ValAddress:   sdk.ValAddress(addr),
+			TimeSource:   network.TimeSource,
+		}
+	}
+
+	// every validator is a persistent peer of every other validator
+	for i, val := range network.Validators {
+		val.Ctx.Config.P2P.PersistentPeers = strings.Join(append(append([]string{}, peers[:i]...), peers[i+1:]...), ",")
+	}
+
+	require.NoError(t, initGenFiles(cfg, genAccounts, genBalances, genTxs, genFiles))
+
+	t.Log("starting test network...")
+	for _, v := range network.Validators {
+		require.NoError(t, startInProcess(cfg, v))
+	}
+
+	t.Log("started test network")
+
+	// ensure the network is producing blocks before returning
+	_, err = network.WaitForHeight(1)
+	require.NoError(t, err)
+
+	return network
+}
+
+// LatestHeight returns the latest height of the network or an error if the
+// query fails or no validator exposes an RPC client.
+func (n *Network) LatestHeight() (int64, error) {
+	if len(n.Validators) == 0 || n.Validators[0].RPCClient == nil {
+		return 0, errors.New("no validators with an RPC client available")
+	}
+
+	status, err := n.Validators[0].RPCClient.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight performs a blocking check where it waits for a block to be
+// committed after a given block. If that height is not reached within a
+// timeout, an error is returned. Regardless, the latest height queried is
+// returned.
+func (n *Network) WaitForHeight(h int64) (int64, error) {
+	return n.WaitForHeightWithTimeout(h, 10*time.Second)
+}
+
+// WaitForHeightWithTimeout is the same as WaitForHeight except the caller can
+// provide a custom timeout.
+func (n *Network) WaitForHeightWithTimeout(h int64, t time.Duration) (int64, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	timeout := time.After(t)
+
+	var latestHeight int64
+
+	for {
+		select {
+		case <-timeout:
+			return latestHeight, fmt.Errorf("timeout exceeded waiting for block %d", h)
+
+		case <-ticker.C:
+			height, err := n.LatestHeight()
+			if err == nil {
+				latestHeight = height
+				if latestHeight >= h {
+					return latestHeight, nil
+				}
+			}
+		}
+	}
+}
+
+// WaitForNextBlock waits for the next block to be committed, returning an
+// error upon failure.
+func (n *Network) WaitForNextBlock() error {
+	lastBlock, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	_, err = n.WaitForHeight(lastBlock + 1)
+	return err
+}
+
+// FastForward advances the header time observed by the modules of all
+// validators by d. The shift applies from two blocks after the latest height,
+// so that every validator observes it at the same height, and the call blocks
+// until that height is committed. It returns the height the shift applies from.
+func (n *Network) FastForward(d time.Duration) (int64, error) {
+	latestHeight, err := n.LatestHeight()
+	if err != nil {
+		return 0, err
+	}
+
+	height := latestHeight + 2
+	n.TimeSource.FastForward(height, d)
+
+	if _, err := n.WaitForHeight(height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+// Cleanup removes the root testing (temporary) directory and stops both the
+// Tendermint and REST services. It allows other callers to create and start
+// test networks. This method must be called when a test is finished,
+// typically in a defer.
+func (n *Network) Cleanup() {
+	defer func() {
+		lock.Unlock()
+		n.T.Log("released test network lock")
+	}()
+
+	n.T.Log("cleaning up test network...")
+
+	for _, v := range n.Validators {
+		if v.tmNode != nil && v.tmNode.IsRunning() {
+			_ = v.tmNode.Stop()
+		}
+
+		if v.api != nil {
+			_ = v.api.Close()
+		}
+	}
+
+	if n.Config.CleanupDir {
+		_ = os.RemoveAll(n.BaseDir)
+	}
+
+	n.T.Log("finished cleaning up test network")
+}
@@ -0,0 +1,54 @@
+package network_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestNetwork(t *testing.T) {
+	cfg := network.DefaultConfig()
+	cfg.TimeoutCommit = 500 * time.Millisecond
+
+	n := network.New(t, cfg)
+	defer n.Cleanup()
+
+	val := n.Validators[0]
+
+	height, err := n.LatestHeight()
+	require.NoError(t, err)
+	require.True(t, height >= 1)
+	require.NoError(t, n.WaitForNextBlock())
+
+	// query the validator through the client context
+	bz, err := val.ClientCtx.Codec.MarshalJSON(staking.NewQueryValidatorParams(val.ValAddress))
+	require.NoError(t, err)
+
+	res, _, err := val.ClientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", staking.QuerierRoute, staking.QueryValidator), bz)
+	require.NoError(t, err)
+
+	var validator staking.Validator
+	require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(res, &validator))
+	require.Equal(t, val.ValAddress, validator.OperatorAddress)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	// query the validator through the REST server
+	apiAddr := strings.Replace(val.APIAddress, "tcp://0.0.0.0", "http://127.0.0.1", 1)
+	resp, err := http.Get(fmt.Sprintf("%s/staking/validators/%s", apiAddr, val.ValAddress))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	require.Contains(t, string(body), val.ValAddress.String())
+}
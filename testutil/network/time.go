@@ -0,0 +1,61 @@
+package network
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.HeaderTimeSource = (*TimeSource)(nil)
+
+type timeShift struct {
+	height int64
+	offset time.Duration
+}
+
+// TimeSource is a deterministic sdk.HeaderTimeSource shared by all validators
+// of a test network. It shifts the header time of every block from a given
+// height onwards, which allows tests to fast-forward the time used by time
+// dependent module logic, e.g. unbonding maturity or jail durations, without
+// waiting for it to pass.
+type TimeSource struct {
+	mtx    sync.RWMutex
+	shifts []timeShift
+}
+
+// NewTimeSource returns a TimeSource which does not shift any header time.
+func NewTimeSource() *TimeSource {
+	return &TimeSource{}
+}
+
+// HeaderTime implements the sdk.HeaderTimeSource interface. It returns the
+// header time shifted by the offsets of all shifts scheduled at or below the
+// header height.
+func (ts *TimeSource) HeaderTime(header abci.Header) time.Time {
+	ts.mtx.RLock()
+	defer ts.mtx.RUnlock()
+
+	headerTime := header.Time
+	for _, s := range ts.shifts {
+		if header.Height >= s.height {
+			headerTime = headerTime.Add(s.offset)
+		}
+	}
+
+	return headerTime
+}
+
+// FastForward shifts the header time of all blocks at or above the given
+// height by d.
+//
+// CONTRACT: no validator may have started processing the given height yet,
+// otherwise validators observe different times and the network halts.
+func (ts *TimeSource) FastForward(height int64, d time.Duration) {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	ts.shifts = append(ts.shifts, timeShift{height: height, offset: d})
+}
@@ -0,0 +1,24 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestTimeSource(t *testing.T) {
+	now := time.Now().UTC()
+	ts := NewTimeSource()
+
+	require.Equal(t, now, ts.HeaderTime(abci.Header{Height: 5, Time: now}))
+
+	ts.FastForward(10, time.Hour)
+	ts.FastForward(20, time.Minute)
+
+	require.Equal(t, now, ts.HeaderTime(abci.Header{Height: 9, Time: now}))
+	require.Equal(t, now.Add(time.Hour), ts.HeaderTime(abci.Header{Height: 10, Time: now}))
+	require.Equal(t, now.Add(time.Hour), ts.HeaderTime(abci.Header{Height: 19, Time: now}))
+	require.Equal(t, now.Add(time.Hour+time.Minute), ts.HeaderTime(abci.Header{Height: 20, Time: now}))
+}
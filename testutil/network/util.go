@@ -0,0 +1,145 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	pvm "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	tmclient "github.com/tendermint/tendermint/rpc/client"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func startInProcess(cfg Config, val *Validator) error {
+	logger := val.Ctx.Logger
+	tmCfg := val.Ctx.Config
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(tmCfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	app := cfg.AppConstructor(*val)
+
+	tmNode, err := node.NewNode(
+		tmCfg,
+		pvm.LoadOrGenFilePV(tmCfg.PrivValidatorKeyFile(), tmCfg.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(app),
+		node.DefaultGenesisDocProviderFunc(tmCfg),
+		node.DefaultDBProvider,
+		node.DefaultMetricsProvider(tmCfg.Instrumentation),
+		logger.With("module", val.Moniker),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+
+	val.tmNode = tmNode
+
+	if val.RPCAddress != "" {
+		val.RPCClient = tmclient.NewLocal(tmNode)
+		val.ClientCtx = val.ClientCtx.WithClient(val.RPCClient)
+	}
+
+	if val.APIAddress != "" {
+		r := mux.NewRouter()
+		rpc.RegisterRPCRoutes(val.ClientCtx, r)
+		authrest.RegisterTxRoutes(val.ClientCtx, r)
+		if cfg.RegisterRoutes != nil {
+			cfg.RegisterRoutes(val.ClientCtx, r)
+		}
+
+		rpcCfg := rpcserver.DefaultConfig()
+		listener, err := rpcserver.Listen(val.APIAddress, rpcCfg)
+		if err != nil {
+			return err
+		}
+
+		val.api = listener
+
+		go func() {
+			_ = rpcserver.StartHTTPServer(listener, r, logger.With("module", "rest-server"), rpcCfg)
+		}()
+	}
+
+	return nil
+}
+
+func initGenFiles(
+	cfg Config, genAccounts []authexported.GenesisAccount, genBalances []bank.Balance,
+	genTxs []auth.StdTx, genFiles []string,
+) error {
+
+	// copy the genesis state so that the configuration is left untouched
+	appGenState := make(map[string]json.RawMessage, len(cfg.GenesisState))
+	for module, state := range cfg.GenesisState {
+		appGenState[module] = state
+	}
+
+	// set the accounts in the genesis state
+	var authGenState auth.GenesisState
+	cfg.Codec.MustUnmarshalJSON(appGenState[auth.ModuleName], &authGenState)
+
+	authGenState.Accounts = append(authGenState.Accounts, genAccounts...)
+	appGenState[auth.ModuleName] = cfg.Codec.MustMarshalJSON(authGenState)
+
+	// set the balances in the genesis state
+	var bankGenState bank.GenesisState
+	cfg.Codec.MustUnmarshalJSON(appGenState[bank.ModuleName], &bankGenState)
+
+	bankGenState.Balances = append(bankGenState.Balances, genBalances...)
+	appGenState[bank.ModuleName] = cfg.Codec.MustMarshalJSON(bankGenState)
+
+	// set the bond denomination in the staking genesis state
+	var stakingGenState staking.GenesisState
+	cfg.Codec.MustUnmarshalJSON(appGenState[staking.ModuleName], &stakingGenState)
+
+	stakingGenState.Params.BondDenom = cfg.BondDenom
+	appGenState[staking.ModuleName] = cfg.Codec.MustMarshalJSON(stakingGenState)
+
+	// set the genesis transactions bonding the validators
+	appGenState, err := genutil.SetGenTxsInAppGenesisState(cfg.Codec, appGenState, genTxs)
+	if err != nil {
+		return err
+	}
+
+	appGenStateJSON, err := codec.MarshalJSONIndent(cfg.Codec, appGenState)
+	if err != nil {
+		return err
+	}
+
+	// all validators must share the same genesis time
+	genDoc := tmtypes.GenesisDoc{
+		GenesisTime: tmtime.Now(),
+		ChainID:     cfg.ChainID,
+		AppState:    appGenStateJSON,
+		Validators:  nil,
+	}
+
+	// generate the genesis file of each validator and save
+	for _, genFile := range genFiles {
+		if err := genDoc.SaveAs(genFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
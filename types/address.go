@@ -9,10 +9,11 @@ import (
 	"strings"
 
 	"github.com/tendermint/tendermint/crypto"
-	tmamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/tendermint/tendermint/libs/bech32"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 const (
@@ -410,6 +411,56 @@ func (va ValAddress) Format(s fmt.State, verb rune) {
 	}
 }
 
+// ValAddressFromAccOrValBech32 creates a ValAddress from either the Bech32
+// encoded operator address of a validator or the Bech32 encoded account address
+// of the operator, as both share the same underlying bytes.
+func ValAddressFromAccOrValBech32(address string) (ValAddress, error) {
+	bz, err := getFromAccOrValBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ValAddress(bz), nil
+}
+
+// AccAddressFromAccOrValBech32 creates an AccAddress from either a Bech32
+// encoded account address or the Bech32 encoded operator address of a
+// validator, in which case the account address of the operator is returned.
+func AccAddressFromAccOrValBech32(address string) (AccAddress, error) {
+	bz, err := getFromAccOrValBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return AccAddress(bz), nil
+}
+
+// getFromAccOrValBech32 decodes the address bytes of a Bech32 string encoded
+// with either the account or the validator operator address prefix.
+func getFromAccOrValBech32(address string) ([]byte, error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return nil, errors.New("decoding Bech32 address failed: must provide an address")
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+
+	accPrefix := GetConfig().GetBech32AccountAddrPrefix()
+	valPrefix := GetConfig().GetBech32ValidatorAddrPrefix()
+
+	if hrp != accPrefix && hrp != valPrefix {
+		return nil, fmt.Errorf("invalid Bech32 prefix; expected %s or %s, got %s", accPrefix, valPrefix, hrp)
+	}
+
+	if err := VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
 // ----------------------------------------------------------------------------
 // consensus node
 // ----------------------------------------------------------------------------
@@ -660,7 +711,7 @@ func GetPubKeyFromBech32(pkt Bech32PubKeyType, pubkeyStr string) (crypto.PubKey,
 		return nil, err
 	}
 
-	pk, err := tmamino.PubKeyFromBytes(bz)
+	pk, err := codec.PubKeyFromBytes(bz)
 	if err != nil {
 		return nil, err
 	}
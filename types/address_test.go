@@ -182,6 +182,39 @@ func TestValAddr(t *testing.T) {
 	}
 }
 
+func TestAccOrValAddress(t *testing.T) {
+	var pub ed25519.PubKeyEd25519
+
+	for i := 0; i < 20; i++ {
+		rand.Read(pub[:])
+
+		accAddr := types.AccAddress(pub.Address())
+		valAddr := types.ValAddress(pub.Address())
+
+		for _, str := range []string{accAddr.String(), valAddr.String()} {
+			resVal, err := types.ValAddressFromAccOrValBech32(str)
+			require.NoError(t, err)
+			require.Equal(t, valAddr, resVal)
+
+			resAcc, err := types.AccAddressFromAccOrValBech32(str)
+			require.NoError(t, err)
+			require.Equal(t, accAddr, resAcc)
+		}
+
+		// consensus addresses are rejected
+		_, err := types.ValAddressFromAccOrValBech32(types.ConsAddress(pub.Address()).String())
+		require.Error(t, err)
+	}
+
+	for _, str := range invalidStrs {
+		_, err := types.ValAddressFromAccOrValBech32(str)
+		require.Error(t, err)
+
+		_, err = types.AccAddressFromAccOrValBech32(str)
+		require.Error(t, err)
+	}
+}
+
 func TestConsAddress(t *testing.T) {
 	var pub ed25519.PubKeyEd25519
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -35,6 +36,7 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	timeSource    HeaderTimeSource
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -56,6 +58,35 @@ func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
 
+// HeaderTime returns the time at which time dependent state transitions, such
+// as maturity checks, are evaluated, in UTC. It defaults to the block header
+// time unless a HeaderTimeSource is set.
+func (c Context) HeaderTime() time.Time {
+	if c.timeSource == nil {
+		return c.header.Time.UTC()
+	}
+
+	return c.timeSource.HeaderTime(c.BlockHeader()).UTC()
+}
+
+// BlockGasRemaining returns the gas left in the block gas meter, or
+// math.MaxUint64 if the block gas is not limited. While a transaction is
+// delivered, neither the gas it has consumed so far nor its gas limit is
+// deducted, the block gas meter is only charged once its messages have run.
+func (c Context) BlockGasRemaining() uint64 {
+	if c.blockGasMeter == nil {
+		return math.MaxUint64
+	}
+
+	// an infinite gas meter has a zero limit but is never out of gas
+	limit := c.blockGasMeter.Limit()
+	if limit == 0 && !c.blockGasMeter.IsOutOfGas() {
+		return math.MaxUint64
+	}
+
+	return limit - c.blockGasMeter.GasConsumedToLimit()
+}
+
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
 	var msg = proto.Clone(&c.header).(*abci.Header)
@@ -179,6 +210,12 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithHeaderTimeSource sets the HeaderTimeSource used by HeaderTime.
+func (c Context) WithHeaderTimeSource(ts HeaderTimeSource) Context {
+	c.timeSource = ts
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -153,6 +154,80 @@ func TestContextHeader(t *testing.T) {
 	require.Equal(t, proposer.Bytes(), ctx.BlockHeader().ProposerAddress)
 }
 
+type offsetTimeSource time.Duration
+
+func (ts offsetTimeSource) HeaderTime(header abci.Header) time.Time {
+	return header.Time.Add(time.Duration(ts))
+}
+
+func TestContextHeaderTime(t *testing.T) {
+	now := time.Now().In(time.FixedZone("UTC+1", 60*60))
+	ctx := types.NewContext(nil, abci.Header{Time: now}, false, nil)
+
+	// defaults to the block header time
+	require.Equal(t, now.UTC(), ctx.HeaderTime())
+
+	ctx = ctx.WithHeaderTimeSource(offsetTimeSource(time.Hour))
+	require.Equal(t, now.Add(time.Hour).UTC(), ctx.HeaderTime())
+	require.Equal(t, now.UTC(), ctx.BlockHeader().Time)
+
+	// the time source is kept when the header changes
+	ctx = ctx.WithBlockTime(now.Add(time.Minute))
+	require.Equal(t, now.Add(time.Minute+time.Hour).UTC(), ctx.HeaderTime())
+}
+
+func TestContextBlockGasRemaining(t *testing.T) {
+	ctx := types.NewContext(nil, abci.Header{}, false, nil)
+	require.Equal(t, uint64(math.MaxUint64), ctx.BlockGasRemaining())
+
+	ctx = ctx.WithBlockGasMeter(types.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(10, "test")
+	require.Equal(t, uint64(math.MaxUint64), ctx.BlockGasRemaining())
+
+	ctx = ctx.WithBlockGasMeter(types.NewGasMeter(100))
+	ctx.BlockGasMeter().ConsumeGas(30, "test")
+	require.Equal(t, uint64(70), ctx.BlockGasRemaining())
+
+	require.Panics(t, func() { ctx.BlockGasMeter().ConsumeGas(80, "test") })
+	require.Equal(t, uint64(0), ctx.BlockGasRemaining())
+
+	ctx = ctx.WithBlockGasMeter(types.NewGasMeter(0))
+	require.Equal(t, uint64(0), ctx.BlockGasRemaining())
+}
+
+func TestContextValues(t *testing.T) {
+	ctx := types.NewContext(nil, abci.Header{}, false, nil)
+
+	_, ok := ctx.ExecMode()
+	require.False(t, ok)
+	require.Nil(t, ctx.TxHash())
+	_, ok = ctx.TxPriority()
+	require.False(t, ok)
+
+	ctx = ctx.WithExecMode(types.ExecModeDeliver).WithTxHash([]byte{0x01}).WithTxPriority(10)
+
+	mode, ok := ctx.ExecMode()
+	require.True(t, ok)
+	require.Equal(t, types.ExecModeDeliver, mode)
+	require.Equal(t, "deliver", mode.String())
+	require.Equal(t, []byte{0x01}, ctx.TxHash())
+	priority, ok := ctx.TxPriority()
+	require.True(t, ok)
+	require.Equal(t, int64(10), priority)
+
+	// keys with the same name do not collide
+	key := types.NewContextKey("exec_mode")
+	_, ok = ctx.ContextValue(key)
+	require.False(t, ok)
+
+	ctx = ctx.WithContextValue(key, "value")
+	value, ok := ctx.ContextValue(key)
+	require.True(t, ok)
+	require.Equal(t, "value", value)
+	mode, _ = ctx.ExecMode()
+	require.Equal(t, types.ExecModeDeliver, mode)
+}
+
 func TestContextHeaderClone(t *testing.T) {
 	cases := map[string]struct {
 		h abci.Header
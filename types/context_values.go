@@ -0,0 +1,109 @@
+package types
+
+import (
+	"context"
+)
+
+// ContextKey is a typed key under which an optional value is carried by a
+// Context. Keys are compared by identity, so every key must be created once,
+// typically as a package level variable, which prevents collisions between
+// packages using the same name.
+type ContextKey struct {
+	name string
+}
+
+// NewContextKey returns a new, unique ContextKey. The name is only used for
+// debugging purposes.
+func NewContextKey(name string) *ContextKey {
+	return &ContextKey{name: name}
+}
+
+// String implements the Stringer interface.
+func (k *ContextKey) String() string {
+	return "sdk context key " + k.name
+}
+
+// WithContextValue returns a Context carrying value under the given key.
+func (c Context) WithContextValue(key *ContextKey, value interface{}) Context {
+	c.ctx = context.WithValue(c.ctx, key, value)
+	return c
+}
+
+// ContextValue returns the value carried under the given key and whether it is
+// set.
+func (c Context) ContextValue(key *ContextKey) (interface{}, bool) {
+	value := c.ctx.Value(key)
+	return value, value != nil
+}
+
+// ExecMode defines the execution mode a transaction is processed in.
+type ExecMode uint8
+
+// Transaction execution modes
+const (
+	ExecModeCheck ExecMode = iota
+	ExecModeReCheck
+	ExecModeSimulate
+	ExecModeDeliver
+)
+
+// String implements the Stringer interface.
+func (m ExecMode) String() string {
+	switch m {
+	case ExecModeCheck:
+		return "check"
+	case ExecModeReCheck:
+		return "recheck"
+	case ExecModeSimulate:
+		return "simulate"
+	case ExecModeDeliver:
+		return "deliver"
+	default:
+		return "unknown"
+	}
+}
+
+// Context keys of the execution metadata set by BaseApp
+var (
+	ContextKeyExecMode   = NewContextKey("exec_mode")
+	ContextKeyTxHash     = NewContextKey("tx_hash")
+	ContextKeyTxPriority = NewContextKey("tx_priority")
+)
+
+// WithExecMode returns a Context carrying the transaction execution mode.
+func (c Context) WithExecMode(mode ExecMode) Context {
+	return c.WithContextValue(ContextKeyExecMode, mode)
+}
+
+// ExecMode returns the transaction execution mode and whether it is set. It is
+// only set while a transaction is processed.
+func (c Context) ExecMode() (ExecMode, bool) {
+	mode, ok := c.ctx.Value(ContextKeyExecMode).(ExecMode)
+	return mode, ok
+}
+
+// WithTxHash returns a Context carrying the hash of the transaction being
+// processed.
+func (c Context) WithTxHash(hash []byte) Context {
+	return c.WithContextValue(ContextKeyTxHash, hash)
+}
+
+// TxHash returns the hash of the transaction being processed or nil if no
+// transaction is processed.
+func (c Context) TxHash() []byte {
+	hash, _ := c.ctx.Value(ContextKeyTxHash).([]byte)
+	return hash
+}
+
+// WithTxPriority returns a Context carrying the priority of the transaction
+// being processed.
+func (c Context) WithTxPriority(priority int64) Context {
+	return c.WithContextValue(ContextKeyTxPriority, priority)
+}
+
+// TxPriority returns the priority of the transaction being processed and
+// whether it is set.
+func (c Context) TxPriority() (int64, bool) {
+	priority, ok := c.ctx.Value(ContextKeyTxPriority).(int64)
+	return priority, ok
+}
@@ -133,11 +133,16 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
+	EventTypeTx      = "tx"
 
 	AttributeKeyAction = "action"
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	// AttributeKeyPriority is set on the tx event of a CheckTx response to the
+	// priority of the transaction
+	AttributeKeyPriority = "priority"
 )
 
 type (
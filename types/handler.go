@@ -12,6 +12,13 @@ type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
 }
 
+// MsgDecoratorRegistry defines the interface for registering AnteDecorators
+// which are only run for transactions containing a message of the given route
+// and type.
+type MsgDecoratorRegistry interface {
+	RegisterMsgDecorator(route, msgType string, decorator AnteDecorator)
+}
+
 // ChainDecorator chains AnteDecorators together with each AnteDecorator
 // wrapping over the decorators further along chain and returns a single AnteHandler.
 //
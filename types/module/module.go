@@ -30,6 +30,9 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -58,6 +61,23 @@ type AppModuleBasic interface {
 	GetQueryCmd(*codec.Codec) *cobra.Command
 }
 
+// HasIndexedEvents is an optional interface an AppModuleBasic may implement to
+// declare the event attributes emitted by the module which Tendermint should
+// index, e.g. since clients filter transactions on them.
+type HasIndexedEvents interface {
+	// IndexedEvents returns the composite keys, {eventType}.{attributeKey}, of
+	// the event attributes to index.
+	IndexedEvents() []string
+}
+
+// HasStoreKeyDecoder is an optional interface an AppModuleBasic may implement
+// to pretty-print the raw keys of its store, e.g. for debugging purposes.
+type HasStoreKeyDecoder interface {
+	// DecodeStoreKey returns a human readable representation of a raw key of
+	// the module's store.
+	DecodeStoreKey(key []byte) (string, error)
+}
+
 // BasicManager is a collection of AppModuleBasic
 type BasicManager map[string]AppModuleBasic
 
@@ -121,6 +141,50 @@ func (bm BasicManager) AddQueryCommands(rootQueryCmd *cobra.Command, cdc *codec.
 	}
 }
 
+// IndexedEvents returns the sorted composite keys of all event attributes to
+// index as declared by the modules, along with the attributes of the message
+// event shared by all modules.
+func (bm BasicManager) IndexedEvents() []string {
+	keys := map[string]struct{}{
+		fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeyAction): {},
+		fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeyModule): {},
+		fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeySender): {},
+	}
+
+	for _, b := range bm {
+		if m, ok := b.(HasIndexedEvents); ok {
+			for _, key := range m.IndexedEvents() {
+				keys[key] = struct{}{}
+			}
+		}
+	}
+
+	indexed := make([]string, 0, len(keys))
+	for key := range keys {
+		indexed = append(indexed, key)
+	}
+
+	sort.Strings(indexed)
+	return indexed
+}
+
+// DecodeStoreKey returns a human readable representation of a raw key of the
+// given module's store. An error is returned if the module does not exist or
+// does not implement HasStoreKeyDecoder.
+func (bm BasicManager) DecodeStoreKey(moduleName string, key []byte) (string, error) {
+	b, ok := bm[moduleName]
+	if !ok {
+		return "", fmt.Errorf("unknown module %s", moduleName)
+	}
+
+	d, ok := b.(HasStoreKeyDecoder)
+	if !ok {
+		return "", fmt.Errorf("module %s does not support decoding store keys", moduleName)
+	}
+
+	return d.DecodeStoreKey(key)
+}
+
 //_________________________________________________________
 
 // AppModuleGenesis is the standard form for an application module genesis functions
@@ -130,6 +194,40 @@ type AppModuleGenesis interface {
 	ExportGenesis(sdk.Context) json.RawMessage
 }
 
+// HasGenesisDependencies is an optional interface an AppModuleGenesis may
+// implement to declare the modules its InitGenesis depends on. The module
+// manager initializes the modules which implement the interface concurrently
+// once their dependencies are initialized, also before modules which precede
+// them in OrderInitGenesis. Modules which do not implement the interface are
+// initialized on their own, after all modules preceding them in
+// OrderInitGenesis.
+//
+// CONTRACT: By implementing the interface a module declares that its InitGenesis
+// only reads or writes state, e.g. accounts or params, which other modules read
+// or write during InitGenesis if they are declared dependencies, declare the
+// module as dependency, or follow the module in OrderInitGenesis without
+// implementing the interface.
+type HasGenesisDependencies interface {
+	// GenesisDependencies returns the names of the modules whose genesis state
+	// has to be initialized before the module's genesis state.
+	GenesisDependencies() []string
+}
+
+// HasMsgDecorators is an optional interface an AppModule may implement to
+// register AnteDecorators which are only run for transactions containing
+// messages of a given route and type, e.g. additional checks of its own
+// messages.
+type HasMsgDecorators interface {
+	RegisterMsgDecorators(sdk.MsgDecoratorRegistry)
+}
+
+// HasRetentionPolicies is an optional interface an AppModule may implement to
+// register retention policies for its records, which are enforced at the end of
+// each block by a PruningManager instead of pruning them itself.
+type HasRetentionPolicies interface {
+	RegisterRetentionPolicies(sdk.RetentionPolicyRegistry)
+}
+
 // AppModule is the standard form for an application module
 type AppModule interface {
 	AppModuleGenesis
@@ -243,6 +341,39 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
+// RegisterMsgDecorators registers the message decorators of all modules which
+// implement HasMsgDecorators. The modules are registered in the order of their
+// names, so that the decorators registered by several modules for the same
+// message are run in a deterministic order.
+func (m *Manager) RegisterMsgDecorators(r sdk.MsgDecoratorRegistry) {
+	for _, name := range m.sortedModuleNames() {
+		if module, ok := m.Modules[name].(HasMsgDecorators); ok {
+			module.RegisterMsgDecorators(r)
+		}
+	}
+}
+
+func (m *Manager) sortedModuleNames() []string {
+	names := make([]string, 0, len(m.Modules))
+	for name := range m.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// RegisterRetentionPolicies registers the retention policies of all modules
+// which implement HasRetentionPolicies. The modules are registered in the order
+// of their names, so that the policies are enforced in a deterministic order.
+func (m *Manager) RegisterRetentionPolicies(r sdk.RetentionPolicyRegistry) {
+	for _, name := range m.sortedModuleNames() {
+		if module, ok := m.Modules[name].(HasRetentionPolicies); ok {
+			module.RegisterRetentionPolicies(r)
+		}
+	}
+}
+
 // RegisterRoutes registers all module routes and module querier routes
 func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter) {
 	for _, module := range m.Modules {
@@ -255,27 +386,139 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
-// InitGenesis performs init genesis functionality for modules
+// InitGenesis performs init genesis functionality for modules. Modules which
+// declare their genesis dependencies are initialized concurrently once their
+// dependencies are initialized (see HasGenesisDependencies). The state changes,
+// events and validator updates of concurrently initialized modules are applied
+// in the order of OrderInitGenesis.
 func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
+	for _, batch := range m.initGenesisBatches(genesisData) {
+		for _, moduleValUpdates := range m.initGenesisBatch(ctx, batch, genesisData) {
+			// use these validator updates if provided, the module manager assumes
+			// only one module will update the validator set
+			if len(moduleValUpdates) > 0 {
+				if len(validatorUpdates) > 0 {
+					panic("validator InitGenesis updates already set by a previous module")
+				}
+				validatorUpdates = moduleValUpdates
+			}
+		}
+	}
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}
+}
+
+// initGenesisBatches splits the modules with a genesis state into batches of
+// modules which can be initialized concurrently. A module which declares its
+// genesis dependencies joins the first batch after the batches of its
+// dependencies, so that it may be initialized before modules which precede it
+// in OrderInitGenesis. A module which does not declare its genesis dependencies
+// is initialized on its own, after all modules preceding it in
+// OrderInitGenesis. The modules of a batch are in the order of
+// OrderInitGenesis.
+func (m *Manager) initGenesisBatches(genesisData map[string]json.RawMessage) (batches [][]string) {
+	var (
+		levels = make(map[string]int)
+		alone  = make(map[int]bool)
+	)
+
 	for _, moduleName := range m.OrderInitGenesis {
 		if genesisData[moduleName] == nil {
 			continue
 		}
-		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, genesisData[moduleName])
 
-		// use these validator updates if provided, the module manager assumes
-		// only one module will update the validator set
-		if len(moduleValUpdates) > 0 {
-			if len(validatorUpdates) > 0 {
-				panic("validator InitGenesis updates already set by a previous module")
+		module, ok := m.Modules[moduleName].(HasGenesisDependencies)
+		if !ok {
+			levels[moduleName] = len(batches)
+			alone[len(batches)] = true
+			batches = append(batches, []string{moduleName})
+			continue
+		}
+
+		level := 0
+		for _, dep := range module.GenesisDependencies() {
+			if _, ok := m.Modules[dep]; !ok || genesisData[dep] == nil {
+				continue
 			}
-			validatorUpdates = moduleValUpdates
+
+			depLevel, ok := levels[dep]
+			if !ok {
+				panic(fmt.Sprintf("module %s depends on module %s, whose genesis is initialized later", moduleName, dep))
+			}
+			if depLevel >= level {
+				level = depLevel + 1
+			}
+		}
+
+		for alone[level] {
+			level++
 		}
+		if level == len(batches) {
+			batches = append(batches, nil)
+		}
+
+		batches[level] = append(batches[level], moduleName)
+		levels[moduleName] = level
 	}
-	return abci.ResponseInitChain{
-		Validators: validatorUpdates,
+
+	return batches
+}
+
+// initGenesisBatch initializes the genesis state of a batch of modules and
+// returns their validator updates. The modules of a batch with more than one
+// module are initialized concurrently, each on a branch of the multi-store with
+// its own event manager and gas meter. The branches are written in the order of
+// the batch. A panic of a module is propagated once all modules have finished.
+func (m *Manager) initGenesisBatch(
+	ctx sdk.Context, batch []string, genesisData map[string]json.RawMessage,
+) [][]abci.ValidatorUpdate {
+
+	updates := make([][]abci.ValidatorUpdate, len(batch))
+	if len(batch) == 1 {
+		updates[0] = m.Modules[batch[0]].InitGenesis(ctx, genesisData[batch[0]])
+		return updates
 	}
+
+	var (
+		wg         sync.WaitGroup
+		stores     = make([]sdk.CacheMultiStore, len(batch))
+		moduleCtxs = make([]sdk.Context, len(batch))
+		panics     = make([]interface{}, len(batch))
+	)
+
+	for i, moduleName := range batch {
+		stores[i] = ctx.MultiStore().CacheMultiStore()
+		moduleCtxs[i] = ctx.WithMultiStore(stores[i]).
+			WithEventManager(sdk.NewEventManager()).
+			WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		wg.Add(1)
+		go func(i int, moduleName string) {
+			defer wg.Done()
+			defer func() {
+				panics[i] = recover()
+			}()
+
+			updates[i] = m.Modules[moduleName].InitGenesis(moduleCtxs[i], genesisData[moduleName])
+		}(i, moduleName)
+	}
+
+	wg.Wait()
+
+	for i := range batch {
+		if panics[i] != nil {
+			panic(panics[i])
+		}
+	}
+
+	for i := range batch {
+		stores[i].Write()
+		ctx.EventManager().EmitEvents(moduleCtxs[i].EventManager().Events())
+	}
+
+	return updates
 }
 
 // ExportGenesis performs export genesis functionality for modules
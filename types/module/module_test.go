@@ -1,10 +1,18 @@
 package module
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestSetOrderBeginBlockers(t *testing.T) {
@@ -14,3 +22,165 @@ func TestSetOrderBeginBlockers(t *testing.T) {
 	require.Equal(t, 3, len(obb))
 	assert.Equal(t, []string{"a", "b", "c"}, obb)
 }
+
+type indexedEventsModule struct {
+	AppModuleBasic
+	name string
+	keys []string
+}
+
+func (m indexedEventsModule) Name() string            { return m.name }
+func (m indexedEventsModule) IndexedEvents() []string { return m.keys }
+
+type plainModule struct {
+	AppModuleBasic
+}
+
+func (plainModule) Name() string { return "plain" }
+
+func TestBasicManagerIndexedEvents(t *testing.T) {
+	bm := NewBasicManager(
+		indexedEventsModule{name: "a", keys: []string{"transfer.recipient", "message.sender"}},
+		indexedEventsModule{name: "b", keys: []string{"delegate.validator"}},
+		plainModule{},
+	)
+
+	require.Equal(t, []string{
+		"delegate.validator",
+		"message.action",
+		"message.module",
+		"message.sender",
+		"transfer.recipient",
+	}, bm.IndexedEvents())
+}
+
+type storeKeyDecoderModule struct {
+	AppModuleBasic
+}
+
+func (storeKeyDecoderModule) Name() string { return "decoder" }
+func (storeKeyDecoderModule) DecodeStoreKey(key []byte) (string, error) {
+	return fmt.Sprintf("key %X", key), nil
+}
+
+func TestBasicManagerDecodeStoreKey(t *testing.T) {
+	bm := NewBasicManager(storeKeyDecoderModule{}, plainModule{})
+
+	out, err := bm.DecodeStoreKey("decoder", []byte{0x01, 0x02})
+	require.NoError(t, err)
+	require.Equal(t, "key 0102", out)
+
+	_, err = bm.DecodeStoreKey("plain", []byte{0x01})
+	require.Error(t, err)
+
+	_, err = bm.DecodeStoreKey("unknown", []byte{0x01})
+	require.Error(t, err)
+}
+
+type genesisModule struct {
+	AppModule
+	name    string
+	key     sdk.StoreKey
+	updates []abci.ValidatorUpdate
+}
+
+func (m genesisModule) Name() string { return m.name }
+func (m genesisModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	ctx.KVStore(m.key).Set([]byte(m.name), data)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(m.name))
+	return m.updates
+}
+
+type independentGenesisModule struct {
+	genesisModule
+	deps []string
+}
+
+func (m independentGenesisModule) GenesisDependencies() []string { return m.deps }
+
+func TestManagerInitGenesisBatches(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+
+	update := abci.ValidatorUpdate{Power: 1}
+	mm := NewManager(
+		independentGenesisModule{genesisModule: genesisModule{name: "a", key: key}},
+		independentGenesisModule{genesisModule: genesisModule{name: "b", key: key, updates: []abci.ValidatorUpdate{update}}},
+		independentGenesisModule{genesisModule: genesisModule{name: "c", key: key}, deps: []string{"a"}},
+		genesisModule{name: "d", key: key},
+		independentGenesisModule{genesisModule: genesisModule{name: "e", key: key}, deps: []string{"d", "f"}},
+		independentGenesisModule{genesisModule: genesisModule{name: "f", key: key}},
+		independentGenesisModule{genesisModule: genesisModule{name: "g", key: key}},
+		independentGenesisModule{genesisModule: genesisModule{name: "h", key: key}, deps: []string{"c"}},
+	)
+	mm.SetOrderInitGenesis("a", "b", "c", "d", "e", "g", "h", "f")
+
+	genesisData := map[string]json.RawMessage{
+		"a": json.RawMessage("1"),
+		"b": json.RawMessage("2"),
+		"c": json.RawMessage("3"),
+		"d": json.RawMessage("4"),
+		"e": json.RawMessage("5"),
+		"g": json.RawMessage("7"),
+		"h": json.RawMessage("8"),
+	}
+
+	// f has no genesis state, so that e can be initialized before it, g has no
+	// dependencies and h is not initialized together with d
+	require.Equal(t, [][]string{{"a", "b", "g"}, {"c"}, {"d"}, {"e", "h"}}, mm.initGenesisBatches(genesisData))
+
+	res := mm.InitGenesis(ctx, genesisData)
+	require.Equal(t, []abci.ValidatorUpdate{update}, res.Validators)
+
+	for name, data := range genesisData {
+		require.Equal(t, []byte(data), ctx.KVStore(key).Get([]byte(name)))
+	}
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Equal(t, []string{"a", "b", "g", "c", "d", "e", "h"}, events)
+
+	// a module cannot depend on a module initialized after it
+	genesisData["f"] = json.RawMessage("6")
+	require.Panics(t, func() { mm.initGenesisBatches(genesisData) })
+}
+
+type msgDecoratorModule struct {
+	AppModule
+	name string
+}
+
+func (m msgDecoratorModule) Name() string { return m.name }
+func (m msgDecoratorModule) RegisterMsgDecorators(r sdk.MsgDecoratorRegistry) {
+	r.RegisterMsgDecorator(m.name, "msg", nopDecorator{})
+}
+
+type nopDecorator struct{}
+
+func (nopDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+type routeRecorder []string
+
+func (r *routeRecorder) RegisterMsgDecorator(route, _ string, _ sdk.AnteDecorator) {
+	*r = append(*r, route)
+}
+
+func TestManagerRegisterMsgDecorators(t *testing.T) {
+	mm := NewManager(
+		msgDecoratorModule{name: "c"},
+		genesisModule{name: "b"},
+		msgDecoratorModule{name: "a"},
+	)
+
+	var routes routeRecorder
+	mm.RegisterMsgDecorators(&routes)
+	require.Equal(t, routeRecorder{"a", "c"}, routes)
+}
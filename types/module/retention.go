@@ -0,0 +1,88 @@
+package module
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.RetentionPolicyRegistry = (*PruningManager)(nil)
+
+// PruningManager enforces the retention policies of the modules, which are
+// registered via the module manager's RegisterRetentionPolicies. At the end of
+// each block, it removes the records which have left the retention window of
+// their policy, in the order in which the policies were registered. If an
+// ArchivalSink is set, the records are passed to it before they are deleted.
+type PruningManager struct {
+	policies []sdk.RetentionPolicy
+	names    map[string]bool
+	sink     sdk.ArchivalSink
+}
+
+func NewPruningManager() *PruningManager {
+	return &PruningManager{
+		names: make(map[string]bool),
+	}
+}
+
+// RegisterRetentionPolicy implements sdk.RetentionPolicyRegistry. It panics if
+// a policy with the same name is already registered.
+func (pm *PruningManager) RegisterRetentionPolicy(policy sdk.RetentionPolicy) {
+	if pm.names[policy.Name] {
+		panic(fmt.Sprintf("retention policy %s already registered", policy.Name))
+	}
+
+	pm.names[policy.Name] = true
+	pm.policies = append(pm.policies, policy)
+}
+
+// Policies returns the registered retention policies.
+func (pm *PruningManager) Policies() []sdk.RetentionPolicy {
+	return pm.policies
+}
+
+// SetArchivalSink sets the sink receiving the records before they are deleted.
+func (pm *PruningManager) SetArchivalSink(sink sdk.ArchivalSink) {
+	pm.sink = sink
+}
+
+// EndBlock removes the expired records of all retention policies.
+func (pm *PruningManager) EndBlock(ctx sdk.Context) {
+	for _, policy := range pm.policies {
+		pm.prune(ctx, policy)
+	}
+}
+
+func (pm *PruningManager) prune(ctx sdk.Context, policy sdk.RetentionPolicy) {
+	cutoff := policy.Cutoff(ctx)
+	if cutoff == nil {
+		return
+	}
+
+	end := make([]byte, 0, len(policy.Prefix)+len(cutoff))
+	end = append(append(end, policy.Prefix...), cutoff...)
+
+	store := ctx.KVStore(policy.StoreKey)
+	iterator := store.Iterator(policy.Prefix, end)
+
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		if pm.sink != nil {
+			if err := pm.sink.Archive(ctx, policy.Name, key, values[i]); err != nil {
+				ctx.Logger().Error("failed to archive pruned record", "policy", policy.Name, "key", fmt.Sprintf("%X", key), "err", err)
+			}
+		}
+
+		store.Delete(key)
+	}
+
+	if len(keys) > 0 {
+		ctx.Logger().Debug("pruned expired records", "policy", policy.Name, "records", len(keys))
+	}
+}
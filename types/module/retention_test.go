@@ -0,0 +1,112 @@
+package module
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type recordSink struct {
+	records []string
+	err     error
+}
+
+func (s *recordSink) Archive(_ sdk.Context, policy string, key, _ []byte) error {
+	s.records = append(s.records, policy+"/"+string(key[1:]))
+	return s.err
+}
+
+func TestPruningManager(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey("test")
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	now := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.NewContext(cms, abci.Header{Height: 10, Time: now}, false, log.NewNopLogger())
+	kvs := ctx.KVStore(key)
+
+	heightPrefix, timePrefix := []byte{0x01}, []byte{0x02}
+	for h := uint64(1); h <= 10; h++ {
+		kvs.Set(append(heightPrefix, sdk.Uint64ToBigEndian(h)...), []byte{0x01})
+	}
+	for d := 1; d <= 10; d++ {
+		kvs.Set(append(timePrefix, sdk.FormatTimeBytes(now.AddDate(0, 0, -d))...), []byte{0x01})
+	}
+	kvs.Set([]byte{0x03}, []byte{0x01})
+
+	blocks := int64(3)
+	pm := NewPruningManager()
+	pm.RegisterRetentionPolicy(sdk.NewHeightRetentionPolicy(
+		"heights", key, heightPrefix, func(sdk.Context) int64 { return blocks },
+	))
+	pm.RegisterRetentionPolicy(sdk.NewTimeRetentionPolicy(
+		"times", key, timePrefix, func(sdk.Context) time.Duration { return 7 * 24 * time.Hour },
+	))
+	require.Panics(t, func() {
+		pm.RegisterRetentionPolicy(sdk.NewHeightRetentionPolicy("heights", key, heightPrefix, nil))
+	})
+	require.Len(t, pm.Policies(), 2)
+
+	sink := &recordSink{err: errors.New("archive unavailable")}
+	pm.SetArchivalSink(sink)
+	pm.EndBlock(ctx)
+
+	count := func(prefix []byte) (n int) {
+		iterator := sdk.KVStorePrefixIterator(kvs, prefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			n++
+		}
+		return n
+	}
+
+	// the records of the 3 recent blocks and the last 7 days are retained, the
+	// expired ones are archived before being deleted even if archiving fails
+	require.Equal(t, 3, count(heightPrefix))
+	require.Equal(t, 7, count(timePrefix))
+	require.True(t, kvs.Has([]byte{0x03}))
+	require.Len(t, sink.records, 10)
+	require.Equal(t, "times/"+string(sdk.FormatTimeBytes(now.AddDate(0, 0, -8))), sink.records[9])
+
+	// a policy without a cutoff retains all records
+	blocks = 0
+	pm.EndBlock(ctx.WithBlockHeight(20))
+	require.Equal(t, 3, count(heightPrefix))
+}
+
+type retentionModule struct {
+	AppModule
+	name string
+}
+
+func (m retentionModule) Name() string { return m.name }
+func (m retentionModule) RegisterRetentionPolicies(r sdk.RetentionPolicyRegistry) {
+	r.RegisterRetentionPolicy(sdk.RetentionPolicy{Name: m.name})
+}
+
+func TestManagerRegisterRetentionPolicies(t *testing.T) {
+	mm := NewManager(
+		retentionModule{name: "c"},
+		genesisModule{name: "b"},
+		retentionModule{name: "a"},
+	)
+
+	pm := NewPruningManager()
+	mm.RegisterRetentionPolicies(pm)
+
+	var names []string
+	for _, policy := range pm.Policies() {
+		names = append(names, policy.Name)
+	}
+	require.Equal(t, []string{"a", "c"}, names)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"time"
+)
+
+// RetentionPolicy declares that the records stored under Prefix in the store of
+// StoreKey are removed once they leave the retention window. Cutoff returns the
+// part of the key following Prefix from which records are retained, so that
+// the records whose keys are before it are expired. A nil cutoff retains all
+// records.
+//
+// CONTRACT: Cutoff must be deterministic, e.g. only depend on the block height,
+// header time and parameters.
+type RetentionPolicy struct {
+	Name     string
+	StoreKey StoreKey
+	Prefix   []byte
+	Cutoff   func(ctx Context) []byte
+}
+
+// NewHeightRetentionPolicy returns a RetentionPolicy retaining the records of
+// the given number of recent blocks. The keys of the records must start with
+// the prefix followed by the height they are recorded at, as returned by
+// Uint64ToBigEndian. A non positive number of blocks retains all records.
+func NewHeightRetentionPolicy(name string, key StoreKey, prefix []byte, blocks func(ctx Context) int64) RetentionPolicy {
+	return RetentionPolicy{
+		Name:     name,
+		StoreKey: key,
+		Prefix:   prefix,
+		Cutoff: func(ctx Context) []byte {
+			n := blocks(ctx)
+			if n <= 0 || ctx.BlockHeight() <= n {
+				return nil
+			}
+
+			return Uint64ToBigEndian(uint64(ctx.BlockHeight() - n + 1))
+		},
+	}
+}
+
+// NewTimeRetentionPolicy returns a RetentionPolicy retaining the records of the
+// given period before the header time. The keys of the records must start with
+// the prefix followed by the time they are recorded at, as returned by
+// FormatTimeBytes. A non positive period retains all records.
+func NewTimeRetentionPolicy(name string, key StoreKey, prefix []byte, period func(ctx Context) time.Duration) RetentionPolicy {
+	return RetentionPolicy{
+		Name:     name,
+		StoreKey: key,
+		Prefix:   prefix,
+		Cutoff: func(ctx Context) []byte {
+			d := period(ctx)
+			if d <= 0 {
+				return nil
+			}
+
+			return FormatTimeBytes(ctx.HeaderTime().Add(-d))
+		},
+	}
+}
+
+// RetentionPolicyRegistry defines the interface for registering the retention
+// policies enforced at the end of each block.
+type RetentionPolicyRegistry interface {
+	RegisterRetentionPolicy(policy RetentionPolicy)
+}
+
+// ArchivalSink receives the records removed by retention policies before they
+// are deleted, e.g. to stream them to an archive outside of the chain.
+//
+// NOTE: Archiving is not part of the state transition. An error is logged and
+// the record is deleted nonetheless, and a sink must not write to the state.
+type ArchivalSink interface {
+	Archive(ctx Context, policy string, key, value []byte) error
+}
@@ -142,6 +142,9 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+// WriteListener is notified of the writes to the KVStores it is added to.
+type WriteListener = types.WriteListener
+
 // --------------------------------------
 
 // nolint - reexport
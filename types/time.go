@@ -0,0 +1,16 @@
+package types
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// HeaderTimeSource defines the source of the time returned by Context.HeaderTime.
+//
+// CONTRACT: implementations must be deterministic, i.e. return the same time
+// for the same header on every node of a network. Otherwise nodes evaluate
+// time dependent state transitions differently and the network halts.
+type HeaderTimeSource interface {
+	HeaderTime(header abci.Header) time.Time
+}
@@ -29,10 +29,13 @@ const (
 var (
 	// functions aliases
 	NewAnteHandler                    = ante.NewAnteHandler
+	NewAnteHandlerWithMsgDecorators   = ante.NewAnteHandlerWithMsgDecorators
+	NewMsgDecorators                  = ante.NewMsgDecorators
 	GetSignerAcc                      = ante.GetSignerAcc
 	DefaultSigVerificationGasConsumer = ante.DefaultSigVerificationGasConsumer
 	DeductFees                        = ante.DeductFees
 	SetGasMeter                       = ante.SetGasMeter
+	GetTxPriority                     = ante.GetTxPriority
 	NewAccountKeeper                  = keeper.NewAccountKeeper
 	NewQuerier                        = keeper.NewQuerier
 	NewBaseAccount                    = types.NewBaseAccount
@@ -75,6 +78,7 @@ var (
 
 type (
 	SignatureVerificationGasConsumer = ante.SignatureVerificationGasConsumer
+	MsgDecorators                    = ante.MsgDecorators
 	AccountKeeper                    = keeper.AccountKeeper
 	BaseAccount                      = types.BaseAccount
 	NodeQuerier                      = types.NodeQuerier
@@ -10,9 +10,21 @@ import (
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+	return NewAnteHandlerWithMsgDecorators(ak, supplyKeeper, sigGasConsumer, NewMsgDecorators())
+}
+
+// NewAnteHandlerWithMsgDecorators returns the AnteHandler of NewAnteHandler
+// which additionally runs the decorators registered for the messages of a tx
+// after its signatures have been verified.
+func NewAnteHandlerWithMsgDecorators(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer,
+	msgDecorators *MsgDecorators,
+) sdk.AnteHandler {
+
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewMempoolFeeDecorator(),
+		NewTxPriorityDecorator(),
 		NewValidateBasicDecorator(),
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
@@ -21,6 +33,7 @@ func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, si
 		NewDeductFeeDecorator(ak, supplyKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak),
+		msgDecorators,
 		NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
 	)
 }
@@ -763,12 +763,17 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
 	tx := types.NewTestTxWithMemo(ctx, msgs, privs, accnums, seqs, fee, "thisisatestmemo")
 
+	// the signatures verified on check tx are remembered by tx hash
+	ctx = ctx.WithTxHash([]byte("thisisatesttx"))
+	_, err := antehandler(ctx.WithIsReCheckTx(false), tx, false)
+	require.Nil(t, err, "AnteHandler errored on check tx unexpectedly: %v", err)
+
 	// make signature array empty which would normally cause ValidateBasicDecorator and SigVerificationDecorator fail
-	// since these decorators don't run on recheck, the tx should pass the antehandler
+	// since these decorators don't run on recheck of a tx verified on check tx, the tx should pass the antehandler
 	stdTx := tx.(types.StdTx)
 	stdTx.Signatures = []types.StdSignature{}
 
-	_, err := antehandler(ctx, stdTx, false)
+	_, err = antehandler(ctx, stdTx, false)
 	require.Nil(t, err, "AnteHandler errored on recheck unexpectedly: %v", err)
 
 	tx = types.NewTestTxWithMemo(ctx, msgs, privs, accnums, seqs, fee, "thisisatestmemo")
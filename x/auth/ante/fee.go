@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -125,3 +126,61 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc exported.A
 
 	return nil
 }
+
+// TxPriorityHook adjusts the priority of a transaction computed from its fee,
+// e.g. to prioritize the transactions of a module, and returns the new priority.
+type TxPriorityHook func(ctx sdk.Context, tx sdk.Tx, priority int64) int64
+
+// TxPriorityDecorator sets the priority of the tx on the context, which is
+// reported to the mempool in the CheckTx response. The priority is the scaled
+// fee per unit of gas returned by GetTxPriority, adjusted by the given hooks in order.
+// CONTRACT: Tx must implement FeeTx interface to use TxPriorityDecorator
+type TxPriorityDecorator struct {
+	hooks []TxPriorityHook
+}
+
+func NewTxPriorityDecorator(hooks ...TxPriorityHook) TxPriorityDecorator {
+	return TxPriorityDecorator{
+		hooks: hooks,
+	}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	priority := GetTxPriority(feeTx.GetFee(), feeTx.GetGas())
+	for _, hook := range tpd.hooks {
+		priority = hook(ctx, tx, priority)
+	}
+
+	return next(ctx.WithTxPriority(priority), tx, simulate)
+}
+
+// TxPriorityPrecision is the factor the fee per unit of gas is scaled by in
+// the priority of a transaction, so that gas prices below one are ordered.
+const TxPriorityPrecision = 1000000
+
+// GetTxPriority returns the priority of a transaction paying the given fee for
+// the given gas limit. As the amounts of different denominations cannot be
+// compared, it is the smallest amount paid per unit of gas in any denomination
+// of the fee times TxPriorityPrecision, capped at math.MaxInt64, or zero if no
+// fee is paid.
+func GetTxPriority(fee sdk.Coins, gas uint64) int64 {
+	if fee.IsZero() || gas == 0 {
+		return 0
+	}
+
+	var priority int64 = math.MaxInt64
+	gasInt := sdk.NewIntFromUint64(gas)
+	for _, coin := range fee {
+		amountPerGas := coin.Amount.MulRaw(TxPriorityPrecision).Quo(gasInt)
+		if amountPerGas.LT(sdk.NewInt(priority)) {
+			priority = amountPerGas.Int64()
+		}
+	}
+
+	return priority
+}
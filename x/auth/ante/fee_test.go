@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -96,3 +97,46 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestTxPriority(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// the smallest fee per gas of any denomination is used
+	fee := types.NewStdFee(1000, sdk.NewCoins(sdk.NewInt64Coin("atom", 5000), sdk.NewInt64Coin("stake", 2500)))
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	var priority int64
+	var found bool
+	capturePriority := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		priority, found = ctx.TxPriority()
+		return ctx, nil
+	}
+
+	tpd := ante.NewTxPriorityDecorator()
+	_, err := tpd.AnteHandle(ctx, tx, false, capturePriority)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(2500000), priority)
+
+	// hooks adjust the priority in order
+	tpd = ante.NewTxPriorityDecorator(
+		func(_ sdk.Context, _ sdk.Tx, priority int64) int64 { return priority + 10 },
+		func(_ sdk.Context, _ sdk.Tx, priority int64) int64 { return priority * 2 },
+	)
+	_, err = tpd.AnteHandle(ctx, tx, false, capturePriority)
+	require.NoError(t, err)
+	require.Equal(t, int64(5000020), priority)
+
+	require.Equal(t, int64(0), ante.GetTxPriority(sdk.NewCoins(), 1000))
+	require.Equal(t, int64(999000), ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("atom", 999)), 1000))
+	require.Equal(t, int64(1), ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), 1000000))
+	require.Equal(t, int64(0), ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 0))
+
+	overflow := sdk.NewCoin("atom", sdk.NewIntFromUint64(math.MaxUint64))
+	require.Equal(t, int64(math.MaxInt64), ante.GetTxPriority(sdk.NewCoins(overflow), 1))
+}
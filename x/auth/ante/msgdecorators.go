@@ -0,0 +1,78 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.MsgDecoratorRegistry = (*MsgDecorators)(nil)
+
+// MsgDecorators is a registry of AnteDecorators scoped to messages of a route
+// and type, which modules register via the module manager's
+// RegisterMsgDecorators. As an AnteDecorator, it runs the decorators registered
+// for the messages of a tx before the next AnteHandler. The decorators of each
+// message type are run once per tx, in the order in which the message types
+// first appear in the tx, and the decorators of a message type in the order of
+// their registration.
+// CONTRACT: Decorators must be registered before the AnteHandler is used.
+type MsgDecorators struct {
+	decorators map[string][]sdk.AnteDecorator
+}
+
+func NewMsgDecorators() *MsgDecorators {
+	return &MsgDecorators{
+		decorators: make(map[string][]sdk.AnteDecorator),
+	}
+}
+
+// RegisterMsgDecorator implements sdk.MsgDecoratorRegistry. It panics if the
+// decorator is nil.
+func (md *MsgDecorators) RegisterMsgDecorator(route, msgType string, decorator sdk.AnteDecorator) {
+	key := msgDecoratorKey(route, msgType)
+	if decorator == nil {
+		panic(fmt.Sprintf("cannot register a nil decorator for messages %s", key))
+	}
+
+	md.decorators[key] = append(md.decorators[key], decorator)
+}
+
+// GetMsgDecorators returns the decorators registered for messages of the given
+// route and type.
+func (md *MsgDecorators) GetMsgDecorators(route, msgType string) []sdk.AnteDecorator {
+	return md.decorators[msgDecoratorKey(route, msgType)]
+}
+
+func (md *MsgDecorators) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	var chain []sdk.AnteDecorator
+	seen := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		key := msgDecoratorKey(msg.Route(), msg.Type())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		chain = append(chain, md.decorators[key]...)
+	}
+
+	if len(chain) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	return sdk.ChainAnteDecorators(append(chain, nextDecorator{next})...)(ctx, tx, simulate)
+}
+
+func msgDecoratorKey(route, msgType string) string {
+	return fmt.Sprintf("%s/%s", route, msgType)
+}
+
+// nextDecorator calls the AnteHandler following MsgDecorators at the end of the
+// chain of message decorators.
+type nextDecorator struct {
+	next sdk.AnteHandler
+}
+
+func (nd nextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return nd.next(ctx, tx, simulate)
+}
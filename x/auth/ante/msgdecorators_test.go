@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+type typedTestMsg struct {
+	*sdk.TestMsg
+	msgType string
+}
+
+func (msg typedTestMsg) Type() string { return msg.msgType }
+
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+// recordDecorator records its name when run and fails if err is set.
+type recordDecorator struct {
+	name   string
+	record *[]string
+	err    error
+}
+
+func (rd recordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*rd.record = append(*rd.record, rd.name)
+	if rd.err != nil {
+		return ctx, rd.err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func TestMsgDecorators(t *testing.T) {
+	var record []string
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		record = append(record, "next")
+		return ctx, nil
+	}
+
+	md := ante.NewMsgDecorators()
+	md.RegisterMsgDecorator("TestMsg", "a", recordDecorator{name: "a1", record: &record})
+	md.RegisterMsgDecorator("TestMsg", "b", recordDecorator{name: "b1", record: &record})
+	md.RegisterMsgDecorator("TestMsg", "a", recordDecorator{name: "a2", record: &record})
+	require.Len(t, md.GetMsgDecorators("TestMsg", "a"), 2)
+	require.Panics(t, func() { md.RegisterMsgDecorator("TestMsg", "b", nil) })
+
+	msgA := typedTestMsg{sdk.NewTestMsg(), "a"}
+	msgB := typedTestMsg{sdk.NewTestMsg(), "b"}
+	msgC := typedTestMsg{sdk.NewTestMsg(), "c"}
+
+	// the decorators of each message type are run once, followed by next
+	_, err := md.AnteHandle(sdk.Context{}, msgsTx{msgB, msgA, msgC, msgA}, false, next)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b1", "a1", "a2", "next"}, record)
+
+	// txs without messages with registered decorators only run next
+	record = nil
+	_, err = md.AnteHandle(sdk.Context{}, msgsTx{msgC}, false, next)
+	require.NoError(t, err)
+	require.Equal(t, []string{"next"}, record)
+
+	// a failing decorator aborts the chain
+	record = nil
+	expErr := errors.New("rejected")
+	md.RegisterMsgDecorator("TestMsg", "c", recordDecorator{name: "c1", record: &record, err: expErr})
+	_, err = md.AnteHandle(sdk.Context{}, msgsTx{msgC, msgA}, false, next)
+	require.Equal(t, expErr, err)
+	require.Equal(t, []string{"c1"}, record)
+}
@@ -0,0 +1,92 @@
+package ante
+
+import (
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// DefaultSigVerificationCacheSize is the number of transactions whose verified
+// signatures are remembered by a SigVerificationDecorator for RecheckTx.
+const DefaultSigVerificationCacheSize = 20000
+
+// signerNonce is the account number and sequence a signature has been verified
+// against.
+type signerNonce struct {
+	accountNumber uint64
+	sequence      uint64
+}
+
+// sigVerificationCache maps the hashes of transactions whose signatures have
+// been verified on CheckTx to the nonces of their signers. As the sign bytes of
+// a transaction only depend on the signer nonces, the signatures stay valid on
+// RecheckTx as long as the nonces of the signers are unchanged.
+type sigVerificationCache struct {
+	cache *lru.Cache
+}
+
+func newSigVerificationCache(size int) *sigVerificationCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(fmt.Errorf("failed to create signature verification cache: %s", err))
+	}
+
+	return &sigVerificationCache{cache: cache}
+}
+
+// add remembers the nonces the signatures of the transaction have been verified
+// against.
+func (c *sigVerificationCache) add(txHash []byte, nonces []signerNonce) {
+	c.cache.Add(string(txHash), nonces)
+}
+
+// remove forgets the transaction, e.g. once it has been delivered.
+func (c *sigVerificationCache) remove(txHash []byte) {
+	c.cache.Remove(string(txHash))
+}
+
+// recheck checks the nonces of the signers of a transaction whose signatures
+// have been verified on CheckTx against their current nonces. It returns false
+// for unknown transactions, whose signatures have to be verified. A transaction
+// failing the check is forgotten.
+func (c *sigVerificationCache) recheck(
+	ctx sdk.Context, ak keeper.AccountKeeper, txHash []byte, signerAddrs []sdk.AccAddress,
+) (bool, error) {
+
+	if txHash == nil {
+		return false, nil
+	}
+
+	value, ok := c.cache.Get(string(txHash))
+	if !ok {
+		return false, nil
+	}
+
+	nonces := value.([]signerNonce)
+	if len(nonces) != len(signerAddrs) {
+		c.remove(txHash)
+		return false, nil
+	}
+
+	for i, addr := range signerAddrs {
+		acc, err := GetSignerAcc(ctx, ak, addr)
+		if err != nil {
+			c.remove(txHash)
+			return false, err
+		}
+
+		if acc.GetAccountNumber() != nonces[i].accountNumber || acc.GetSequence() != nonces[i].sequence {
+			c.remove(txHash)
+			return false, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "signature verification failed; account sequence mismatch, expected %d, got %d",
+				acc.GetSequence(), nonces[i].sequence,
+			)
+		}
+	}
+
+	return true, nil
+}
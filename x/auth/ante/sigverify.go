@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/ethsecp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -154,30 +155,43 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 }
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
-// the SigVerificationDecorator decorator will not get executed on ReCheck.
+// the signatures of a tx verified on CheckTx are not verified again on ReCheck.
+// Instead, the account numbers and sequences they have been verified against are
+// compared to the current ones of the signers. The signatures of a tx which is
+// not remembered, e.g. as it has been evicted from the cache, are verified.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
-	ak keeper.AccountKeeper
+	ak    keeper.AccountKeeper
+	cache *sigVerificationCache
 }
 
 func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorator {
 	return SigVerificationDecorator{
-		ak: ak,
+		ak:    ak,
+		cache: newSigVerificationCache(DefaultSigVerificationCacheSize),
 	}
 }
 
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	// no need to verify signatures on recheck tx
-	if ctx.IsReCheckTx() {
-		return next(ctx, tx, simulate)
-	}
 	sigTx, ok := tx.(SigVerifiableTx)
 	if !ok {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// no need to verify signatures on recheck tx verified on check tx, only the
+	// signer sequences may have changed since
+	if ctx.IsReCheckTx() {
+		verified, err := svd.cache.recheck(ctx, svd.ak, ctx.TxHash(), sigTx.GetSigners())
+		if err != nil {
+			return ctx, err
+		}
+		if verified {
+			return next(ctx, tx, simulate)
+		}
+	}
+
 	// stdSigs contains the sequence number, account number, and signatures.
 	// When simulating, this would just be a 0-length slice.
 	sigs := sigTx.GetSignatures()
@@ -213,6 +227,19 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	if txHash := ctx.TxHash(); txHash != nil && !simulate {
+		if ctx.IsCheckTx() {
+			nonces := make([]signerNonce, len(signerAccs))
+			for i, acc := range signerAccs {
+				nonces[i] = signerNonce{accountNumber: acc.GetAccountNumber(), sequence: acc.GetSequence()}
+			}
+			svd.cache.add(txHash, nonces)
+		} else {
+			// a delivered tx leaves the mempool
+			svd.cache.remove(txHash)
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
 
@@ -320,6 +347,21 @@ func DefaultSigVerificationGasConsumer(
 	}
 }
 
+// EthSigVerificationGasConsumer is a SignatureVerificationGasConsumer which in
+// addition to the public keys accepted by DefaultSigVerificationGasConsumer
+// accepts eth_secp256k1 public keys, e.g. of Ethereum wallets. Their signature
+// verification is charged the secp256k1 cost.
+func EthSigVerificationGasConsumer(
+	meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params,
+) error {
+	if _, ok := pubkey.(ethsecp256k1.PubKeyEthSecp256k1); ok {
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+	}
+
+	return DefaultSigVerificationGasConsumer(meter, sig, pubkey, params)
+}
+
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
 func ConsumeMultisignatureVerificationGas(meter sdk.GasMeter,
 	sig multisig.Multisignature, pubkey multisig.PubKeyMultisigThreshold,
@@ -10,7 +10,9 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/ethsecp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -142,7 +144,7 @@ func TestSigVerification(t *testing.T) {
 		{"wrong accnums", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{7, 8, 9}, []uint64{0, 0, 0}, false, true},
 		{"wrong sequences", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{3, 4, 5}, false, true},
 		{"valid tx", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{0, 0, 0}, false, false},
-		{"no err on recheck", []crypto.PrivKey{}, []uint64{}, []uint64{}, true, false},
+		{"unknown tx on recheck", []crypto.PrivKey{}, []uint64{}, []uint64{}, true, true},
 	}
 	for i, tc := range testCases {
 		ctx = ctx.WithIsReCheckTx(tc.recheck)
@@ -158,6 +160,56 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationReCheck(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	acc1 = app.AccountKeeper.GetAccount(ctx, addr1)
+
+	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{acc1.GetAccountNumber()}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+
+	// the signatures verified on check tx are remembered by tx hash
+	checkCtx := ctx.WithTxHash([]byte("tx1"))
+	_, err := antehandler(checkCtx, tx, false)
+	require.NoError(t, err)
+
+	// signatures are not verified again on recheck
+	stdTx := tx.(types.StdTx)
+	stdTx.Signatures = []types.StdSignature{}
+	recheckCtx := checkCtx.WithIsReCheckTx(true)
+	_, err = antehandler(recheckCtx, stdTx, false)
+	require.NoError(t, err)
+
+	// but the signer sequences are checked
+	require.NoError(t, acc1.SetSequence(1))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	_, err = antehandler(recheckCtx, tx, false)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), "unexpected error: %v", err)
+
+	// delivered txs are forgotten, so that their signatures are verified again
+	require.NoError(t, acc1.SetSequence(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	_, err = antehandler(checkCtx, tx, false)
+	require.NoError(t, err)
+	_, err = antehandler(checkCtx.WithIsCheckTx(false), tx, false)
+	require.NoError(t, err)
+
+	_, err = antehandler(recheckCtx, stdTx, false)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), "unexpected error: %v", err)
+	_, err = antehandler(recheckCtx, tx, false)
+	require.NoError(t, err)
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
@@ -211,3 +263,40 @@ func runSigDecorators(t *testing.T, params types.Params, multisig bool, privs ..
 
 	return after - before, err
 }
+
+func TestEthSecp256k1Signatures(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	priv := ethsecp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	privs, accNums, seqs := []crypto.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+
+	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+
+	// eth_secp256k1 keys are rejected by default
+	sgcd := ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
+	_, err := sdk.ChainAnteDecorators(spkd, sgcd, svd)(ctx, tx, false)
+	require.True(t, sdkerrors.ErrInvalidPubKey.Is(err), "unexpected error: %v", err)
+
+	meter := sdk.NewInfiniteGasMeter()
+	sgcd = ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.EthSigVerificationGasConsumer)
+	_, err = sdk.ChainAnteDecorators(spkd, sgcd, svd)(ctx.WithGasMeter(meter), tx, false)
+	require.NoError(t, err)
+	require.True(t, meter.GasConsumed() >= types.DefaultSigVerifyCostSecp256k1)
+
+	// other keys are handled by the default consumer
+	meter = sdk.NewInfiniteGasMeter()
+	err = ante.EthSigVerificationGasConsumer(meter, nil, secp256k1.GenPrivKey().PubKey(), types.DefaultParams())
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256k1, meter.GasConsumed())
+	require.Error(t, ante.EthSigVerificationGasConsumer(meter, nil, ed25519.GenPrivKey().PubKey(), types.DefaultParams()))
+}
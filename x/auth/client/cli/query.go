@@ -21,7 +21,8 @@ import (
 )
 
 const (
-	flagEvents = "events"
+	flagEvents       = "events"
+	flagMempoolLimit = "mempool-limit"
 
 	eventFormat = "{eventType}.{eventAttribute}={value}"
 )
@@ -36,7 +37,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetAccountCmd(cdc))
+	cmd.AddCommand(
+		GetAccountCmd(cdc),
+		GetSequenceStatusCmd(cdc),
+		QueryTxWithProofCmd(cdc),
+	)
 
 	return cmd
 }
@@ -69,6 +74,48 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetSequenceStatusCmd returns a query command that reports the committed
+// sequence of an account and the sequences of its transactions pending in the
+// mempool of the node.
+func GetSequenceStatusCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sequence [address]",
+		Short: "Query the committed and pending sequences of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the sequence of an account in the latest committed state together
+with the sequences of the transactions it signed that are pending in the mempool
+of the node. Sequences missing between the committed and the highest pending
+sequence are reported as gaps; pending transactions above a gap are stuck until
+a transaction with the missing sequence is broadcast. Pending transactions whose
+signature does not match any uncommitted sequence are reported as stale.
+
+Example:
+$ %s query auth sequence cosmos1...
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			status, err := authclient.QuerySequenceStatus(cliCtx, addr, viper.GetInt(flagMempoolLimit))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(status)
+		},
+	}
+
+	cmd.Flags().Int(flagMempoolLimit, 100, "Maximum number of mempool transactions to inspect")
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -182,3 +229,41 @@ func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// QueryTxWithProofCmd implements the default command for a tx query returning
+// the proof of the inclusion of the transaction in its block.
+func QueryTxWithProofCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-proof [hash]",
+		Short: "Query for a transaction by hash along with a proof of its inclusion in a committed block",
+		Long: strings.TrimSpace(`
+Query for a transaction by hash in a committed block, along with the merkle proof of
+its inclusion in the data hash of the block header and the signed header itself. The
+proof is verified against the header, which is verified by the light client unless
+the node is trusted. Only the transaction itself is proven, its result including the
+code, logs and events is as reported by the node.
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			output, err := authclient.QueryTxWithProof(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if output.Tx.Empty() {
+				return fmt.Errorf("no transaction found with hash %s", args[0])
+			}
+
+			return cliCtx.PrintOutput(output)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify the block header)")
+	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+
+	return cmd
+}
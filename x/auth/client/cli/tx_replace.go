@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	flagFeeAdjustment = "fee-adjustment"
+)
+
+// GetReplaceCommand returns the command to replace a transaction that is stuck
+// in the mempool.
+func GetReplaceCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace [file]",
+		Short: "Sign and broadcast a transaction again to recover from a sequence gap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Read a transaction from [file], sign its messages and memo again with the
+--from account and broadcast it. Transactions that were dropped from the mempool
+or signed with a wrong sequence leave a gap in the sequences of the account that
+keeps all subsequent transactions pending.
+
+Unless --sequence is given, the transaction is signed with the first sequence
+that is neither committed nor used by a pending transaction, as reported by the
+'query auth sequence' command. The command fails if the original transaction is
+still valid with another sequence that is not committed yet, as it would then be
+executed along with its replacement once the gaps below it are filled. Such a
+transaction can only be replaced with --sequence set to its own sequence. Unless
+--fees or --gas-prices are given, the fees
+of the original transaction multiplied by --fee-adjustment are paid, and unless
+--gas is given, its gas limit is kept.
+
+Example:
+$ %s tx auth replace ./stuck-tx.json --from mykey --fee-adjustment 1.5
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := types.NewTxBuilderFromCLI(inBuf).WithTxEncoder(client.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			stdTx, err := client.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("gas") {
+				txBldr = txBldr.WithGas(stdTx.Fee.Gas)
+			}
+
+			feeAdjustment, err := sdk.NewDecFromStr(viper.GetString(flagFeeAdjustment))
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := client.BuildReplacementTx(
+				txBldr, cliCtx, stdTx, feeAdjustment, viper.GetInt(flagMempoolLimit), !cmd.Flags().Changed(flags.FlagSequence),
+			)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().String(flagFeeAdjustment, "1.1", "Factor the fees of the original transaction are multiplied by")
+	cmd.Flags().Int(flagMempoolLimit, 100, "Maximum number of mempool transactions to inspect")
+
+	return flags.PostCommands(cmd)[0]
+}
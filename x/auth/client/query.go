@@ -1,12 +1,15 @@
 package client
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -108,6 +111,97 @@ func QueryTx(cliCtx context.CLIContext, hashHexStr string) (sdk.TxResponse, erro
 	return out, nil
 }
 
+// TxWithProof defines a committed transaction along with a Merkle proof of its
+// inclusion in the data hash of the header of its block. The header is signed
+// by the commit of the block, so that the inclusion can be verified against a
+// trusted validator set.
+//
+// NOTE: Only the transaction itself is proven. Its result, i.e. the code, logs,
+// events and gas used, is as reported by the node, since the results of a block
+// are only committed to by the header of the next block.
+type TxWithProof struct {
+	Tx     sdk.TxResponse       `json:"tx" yaml:"tx"`
+	Proof  tmtypes.TxProof      `json:"proof" yaml:"proof"`
+	Header tmtypes.SignedHeader `json:"header" yaml:"header"`
+}
+
+// QueryTxWithProof queries for a single transaction by a hash string in hex
+// format along with the proof of its inclusion in its block. Unless the node is
+// trusted, the header of the block is verified by the light client. The proof
+// is always verified against the data hash of the header, and the transaction
+// returned is decoded from the proven bytes. An error is returned if the
+// transaction does not exist or cannot be queried or verified.
+func QueryTxWithProof(cliCtx context.CLIContext, hashHexStr string) (TxWithProof, error) {
+	hash, err := hex.DecodeString(hashHexStr)
+	if err != nil {
+		return TxWithProof{}, err
+	}
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return TxWithProof{}, err
+	}
+
+	resTx, err := node.Tx(hash, true)
+	if err != nil {
+		return TxWithProof{}, err
+	}
+
+	var header tmtypes.SignedHeader
+	if cliCtx.TrustNode {
+		resCommit, err := node.Commit(&resTx.Height)
+		if err != nil {
+			return TxWithProof{}, err
+		}
+
+		header = resCommit.SignedHeader
+	} else {
+		header, err = cliCtx.Verify(resTx.Height)
+		if err != nil {
+			return TxWithProof{}, err
+		}
+	}
+
+	if err := VerifyTxProof(hash, resTx.Tx, resTx.Height, resTx.Proof, header); err != nil {
+		return TxWithProof{}, err
+	}
+
+	resBlocks, err := getBlocksForTxResults(cliCtx, []*ctypes.ResultTx{resTx})
+	if err != nil {
+		return TxWithProof{}, err
+	}
+
+	out, err := formatTxResult(cliCtx.Codec, resTx, resBlocks[resTx.Height])
+	if err != nil {
+		return TxWithProof{}, err
+	}
+
+	return TxWithProof{Tx: out, Proof: resTx.Proof, Header: header}, nil
+}
+
+// VerifyTxProof verifies that the proof proves the inclusion of the given
+// transaction with the given hash in the data hash of the header of the block at
+// the given height.
+func VerifyTxProof(hash []byte, tx tmtypes.Tx, height int64, proof tmtypes.TxProof, header tmtypes.SignedHeader) error {
+	if header.Header == nil {
+		return errors.New("missing block header")
+	}
+
+	if header.Height != height {
+		return fmt.Errorf("header height %d does not match transaction height %d", header.Height, height)
+	}
+
+	if !bytes.Equal(proof.Leaf(), hash) {
+		return fmt.Errorf("proof is for transaction %X instead of %X", proof.Leaf(), hash)
+	}
+
+	if !bytes.Equal(proof.Data, tx) {
+		return fmt.Errorf("proof is for other bytes than transaction %X", hash)
+	}
+
+	return proof.Validate(header.DataHash)
+}
+
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
 func formatTxResults(cdc *codec.Codec, resTxs []*ctypes.ResultTx, resBlocks map[int64]*ctypes.ResultBlock) ([]sdk.TxResponse, error) {
 	var err error
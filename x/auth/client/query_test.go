@@ -0,0 +1,31 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestVerifyTxProof(t *testing.T) {
+	txs := tmtypes.Txs{tmtypes.Tx("tx1"), tmtypes.Tx("tx2"), tmtypes.Tx("tx3")}
+	header := tmtypes.SignedHeader{Header: &tmtypes.Header{Height: 10, DataHash: txs.Hash()}}
+	proof := txs.Proof(1)
+
+	require.NoError(t, VerifyTxProof(txs[1].Hash(), txs[1], 10, proof, header))
+
+	// proof of another transaction
+	require.Error(t, VerifyTxProof(txs[0].Hash(), txs[0], 10, proof, header))
+
+	// proof of other bytes than the transaction
+	require.Error(t, VerifyTxProof(txs[1].Hash(), txs[0], 10, proof, header))
+
+	// header of another block
+	require.Error(t, VerifyTxProof(txs[1].Hash(), txs[1], 11, proof, header))
+	otherTxs := tmtypes.Txs{tmtypes.Tx("tx4")}
+	otherHeader := tmtypes.SignedHeader{Header: &tmtypes.Header{Height: 10, DataHash: otherTxs.Hash()}}
+	require.Error(t, VerifyTxProof(txs[1].Hash(), txs[1], 10, proof, otherHeader))
+
+	// missing header
+	require.Error(t, VerifyTxProof(txs[1].Hash(), txs[1], 10, proof, tmtypes.SignedHeader{}))
+}
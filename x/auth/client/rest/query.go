@@ -54,6 +54,37 @@ func QueryAccountRequestHandlerFn(storeName string, cliCtx context.CLIContext) h
 	}
 }
 
+// QuerySequenceStatusRequestHandlerFn implements a REST handler that reports the
+// committed sequence of an account and the sequences of its transactions pending
+// in the mempool of the node. The optional limit parameter bounds the number of
+// inspected mempool transactions.
+func QuerySequenceStatusRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		limit := int64(100)
+		if limitStr := r.FormValue("limit"); limitStr != "" {
+			var ok bool
+			limit, ok = rest.ParseInt64OrReturnBadRequest(w, limitStr)
+			if !ok {
+				return
+			}
+		}
+
+		status, err := client.QuerySequenceStatus(cliCtx, addr, int(limit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, status)
+	}
+}
+
 // QueryTxsHandlerFn implements a REST handler that searches for transactions.
 // Genesis transactions are returned if the height parameter is set to zero,
 // otherwise the transactions are searched for by events.
@@ -138,3 +169,35 @@ func QueryTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponseBare(w, cliCtx, output)
 	}
 }
+
+// QueryTxWithProofRequestHandlerFn implements a REST handler that queries a
+// transaction by hash in a committed block along with the proof of its
+// inclusion in the block.
+func QueryTxWithProofRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		hashHexStr := vars["hash"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		output, err := client.QueryTxWithProof(cliCtx, hashHexStr)
+		if err != nil {
+			if strings.Contains(err.Error(), hashHexStr) {
+				rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+				return
+			}
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if output.Tx.Empty() {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("no transaction found with hash %s", hashHexStr))
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, output)
+	}
+}
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SequenceStatus reports the committed sequence of an account together with
+// the sequences of its transactions pending in the mempool of a node.
+type SequenceStatus struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	// Sequence is the sequence of the account in the latest committed state.
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+	// PendingSequences are the sorted sequences of the pending transactions
+	// signed by the account.
+	PendingSequences []uint64 `json:"pending_sequences" yaml:"pending_sequences"`
+	// StaleTxs is the number of pending transactions signed by the account
+	// whose signature does not match any sequence not yet committed. They are
+	// evicted from the mempool on recheck.
+	StaleTxs int `json:"stale_txs" yaml:"stale_txs"`
+	// Gaps are the sequences missing between the committed sequence and the
+	// highest pending sequence. Pending transactions above a gap can not be
+	// included in a block until the gap is filled.
+	Gaps []uint64 `json:"gaps" yaml:"gaps"`
+	// NextSequence is the lowest sequence neither used by a committed nor by a
+	// pending transaction.
+	NextSequence uint64 `json:"next_sequence" yaml:"next_sequence"`
+	// MempoolTxs is the total number of transactions in the mempool of the
+	// node and ScannedTxs the number of them that have been inspected.
+	MempoolTxs int `json:"mempool_txs" yaml:"mempool_txs"`
+	ScannedTxs int `json:"scanned_txs" yaml:"scanned_txs"`
+}
+
+// String implements the Stringer interface.
+func (s SequenceStatus) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Sequence Status:
+  Address:           %s
+  Account Number:    %d
+  Sequence:          %d
+  Pending Sequences: %v
+  Stale Txs:         %d
+  Gaps:              %v
+  Next Sequence:     %d
+  Mempool Txs:       %d (%d scanned)`,
+		s.Address, s.AccountNumber, s.Sequence, s.PendingSequences, s.StaleTxs,
+		s.Gaps, s.NextSequence, s.MempoolTxs, s.ScannedTxs,
+	))
+}
+
+// QuerySequenceStatus queries the account of the given address and up to limit
+// transactions of the mempool of the node and reports the sequences of the
+// pending transactions signed by the account as well as any gap between them.
+func QuerySequenceStatus(cliCtx context.CLIContext, addr sdk.AccAddress, limit int) (SequenceStatus, error) {
+	acc, err := authtypes.NewAccountRetriever(cliCtx).GetAccount(addr)
+	if err != nil {
+		return SequenceStatus{}, err
+	}
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return SequenceStatus{}, err
+	}
+
+	nodeStatus, err := node.Status()
+	if err != nil {
+		return SequenceStatus{}, err
+	}
+
+	resTxs, err := node.UnconfirmedTxs(limit)
+	if err != nil {
+		return SequenceStatus{}, err
+	}
+
+	var pending []authtypes.StdTx
+	for _, txBytes := range resTxs.Txs {
+		// skip transactions which are not standard transactions
+		tx, err := parseTx(cliCtx.Codec, txBytes)
+		if err != nil {
+			continue
+		}
+
+		stdTx := tx.(authtypes.StdTx)
+		if isTxSigner(addr, stdTx.GetSigners()) {
+			pending = append(pending, stdTx)
+		}
+	}
+
+	status := NewSequenceStatus(nodeStatus.NodeInfo.Network, acc, pending)
+	status.MempoolTxs = resTxs.Total
+	status.ScannedTxs = resTxs.Count
+
+	return status, nil
+}
+
+// NewSequenceStatus returns the SequenceStatus of an account given the pending
+// transactions it signed. As signatures do not carry the sequence they were
+// created with, the sequence of a transaction is recovered by verifying its
+// signature against the sign bytes of the sequences following the committed one.
+func NewSequenceStatus(chainID string, acc exported.Account, txs []authtypes.StdTx) SequenceStatus {
+	status := SequenceStatus{
+		Address:          acc.GetAddress(),
+		AccountNumber:    acc.GetAccountNumber(),
+		Sequence:         acc.GetSequence(),
+		PendingSequences: []uint64{},
+		Gaps:             []uint64{},
+	}
+
+	// bound the search, allowing as many gaps as there are pending txs
+	maxSequence := status.Sequence + 2*uint64(len(txs))
+	seen := make(map[uint64]bool)
+
+	for _, tx := range txs {
+		seq, ok := findTxSequence(chainID, acc, tx, maxSequence)
+		if !ok {
+			status.StaleTxs++
+			continue
+		}
+
+		if !seen[seq] {
+			seen[seq] = true
+			status.PendingSequences = append(status.PendingSequences, seq)
+		}
+	}
+
+	sort.Slice(status.PendingSequences, func(i, j int) bool {
+		return status.PendingSequences[i] < status.PendingSequences[j]
+	})
+
+	status.NextSequence = status.Sequence
+	if n := len(status.PendingSequences); n > 0 {
+		for seq := status.Sequence; seq < status.PendingSequences[n-1]; seq++ {
+			if !seen[seq] {
+				status.Gaps = append(status.Gaps, seq)
+			}
+		}
+
+		status.NextSequence = status.PendingSequences[n-1] + 1
+		if len(status.Gaps) > 0 {
+			status.NextSequence = status.Gaps[0]
+		}
+	}
+
+	return status
+}
+
+// findTxSequence returns the sequence, between the committed sequence of the
+// account and maxSequence, the account's signature on the transaction was
+// created with.
+func findTxSequence(chainID string, acc exported.Account, tx authtypes.StdTx, maxSequence uint64) (uint64, bool) {
+	var sig authtypes.StdSignature
+	for i, signer := range tx.GetSigners() {
+		if signer.Equals(acc.GetAddress()) && i < len(tx.Signatures) {
+			sig = tx.Signatures[i]
+			break
+		}
+	}
+
+	pubKey := sig.PubKey
+	if pubKey == nil {
+		pubKey = acc.GetPubKey()
+	}
+	if pubKey == nil || len(sig.Signature) == 0 {
+		return 0, false
+	}
+
+	for seq := acc.GetSequence(); seq <= maxSequence; seq++ {
+		signBytes := authtypes.StdSignBytes(chainID, acc.GetAccountNumber(), seq, tx.Fee, tx.Msgs, tx.Memo)
+		if pubKey.VerifyBytes(signBytes, sig.Signature) {
+			return seq, true
+		}
+	}
+
+	return 0, false
+}
+
+// BuildReplacementTx signs the messages and the memo of stdTx again with the
+// account of the given context in order to replace a transaction stuck in the
+// mempool, and returns the encoded transaction. If nextSequence is true, the
+// next sequence reported by QuerySequenceStatus for up to limit mempool
+// transactions is used instead of the sequence of the TxBuilder. Unless the
+// TxBuilder has fees or gas prices set, the fees of stdTx multiplied by
+// feeAdjustment are paid.
+//
+// An error is returned if stdTx can still be included in a block with another
+// sequence than the replacement, as both transactions would be executed then.
+func BuildReplacementTx(
+	txBldr authtypes.TxBuilder, cliCtx context.CLIContext, stdTx authtypes.StdTx,
+	feeAdjustment sdk.Dec, limit int, nextSequence bool,
+) ([]byte, error) {
+
+	from := cliCtx.GetFromAddress()
+
+	signers := stdTx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(from) {
+		return nil, errors.New("only transactions signed by the sender alone can be replaced")
+	}
+
+	if feeAdjustment.LT(sdk.OneDec()) {
+		return nil, fmt.Errorf("fee adjustment must be at least 1: %s", feeAdjustment)
+	}
+
+	status, err := QuerySequenceStatus(cliCtx, from, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	txBldr = txBldr.WithAccountNumber(status.AccountNumber).WithMemo(stdTx.Memo)
+	if nextSequence {
+		txBldr = txBldr.WithSequence(status.NextSequence)
+	}
+
+	acc, err := authtypes.NewAccountRetriever(cliCtx).GetAccount(from)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkReplacement(txBldr.ChainID(), acc, stdTx, txBldr.Sequence(), status); err != nil {
+		return nil, err
+	}
+
+	if txBldr.SimulateAndExecute() {
+		txBldr, err = EnrichWithGas(txBldr, cliCtx, stdTx.Msgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stdSignMsg, err := txBldr.BuildSignMsg(stdTx.Msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if txBldr.Fees().IsZero() && txBldr.GasPrices().IsZero() {
+		stdSignMsg.Fee = authtypes.NewStdFee(txBldr.Gas(), adjustFees(stdTx.Fee.Amount, feeAdjustment))
+	}
+
+	_, _ = fmt.Fprintf(
+		os.Stderr, "replacing transaction with sequence %d, gas %d and fees %s\n",
+		stdSignMsg.Sequence, stdSignMsg.Fee.Gas, stdSignMsg.Fee.Amount,
+	)
+
+	return txBldr.Sign(cliCtx.GetFromName(), keys.DefaultKeyPass, stdSignMsg)
+}
+
+// checkReplacement returns an error if the signature of the account on stdTx is
+// valid for a sequence which is not committed yet, bounded as by
+// NewSequenceStatus, other than the sequence of the replacement. The original
+// transaction could then be included in a block after its replacement.
+func checkReplacement(
+	chainID string, acc exported.Account, stdTx authtypes.StdTx, sequence uint64, status SequenceStatus,
+) error {
+
+	maxSequence := status.NextSequence + 2*uint64(len(status.PendingSequences)+1)
+	if sequence > maxSequence {
+		maxSequence = sequence
+	}
+
+	seq, ok := findTxSequence(chainID, acc, stdTx, maxSequence)
+	if ok && seq != sequence {
+		return fmt.Errorf(
+			"transaction is still valid with sequence %d and would be executed along with its replacement; "+
+				"fill the gaps below it or replace it with the same sequence", seq,
+		)
+	}
+
+	return nil
+}
+
+// adjustFees multiplies the fees by the adjustment, rounding up.
+func adjustFees(fees sdk.Coins, adjustment sdk.Dec) sdk.Coins {
+	adjusted := make(sdk.Coins, len(fees))
+	for i, fee := range fees {
+		adjusted[i] = sdk.NewCoin(fee.Denom, fee.Amount.ToDec().Mul(adjustment).Ceil().RoundInt())
+	}
+
+	return adjusted
+}
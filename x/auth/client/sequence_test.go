@@ -0,0 +1,104 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestNewSequenceStatus(t *testing.T) {
+	const chainID = "test-chain"
+
+	priv, pub, addr := authtypes.KeyTestPubAddr()
+	acc := authtypes.NewBaseAccount(addr, pub, 3, 5)
+	fee := authtypes.NewTestStdFee()
+
+	makeTx := func(seq uint64) authtypes.StdTx {
+		msgs := []sdk.Msg{authtypes.NewTestMsg(addr)}
+		signBytes := authtypes.StdSignBytes(chainID, 3, seq, fee, msgs, "")
+		tx := authtypes.NewTestTxWithSignBytes(msgs, []crypto.PrivKey{priv}, []uint64{3}, []uint64{seq}, fee, signBytes, "")
+		return tx.(authtypes.StdTx)
+	}
+
+	testCases := []struct {
+		name    string
+		seqs    []uint64
+		pending []uint64
+		gaps    []uint64
+		stale   int
+		nextSeq uint64
+	}{
+		{"no pending txs", nil, []uint64{}, []uint64{}, 0, 5},
+		{"contiguous", []uint64{6, 5, 7}, []uint64{5, 6, 7}, []uint64{}, 0, 8},
+		{"gaps", []uint64{6, 8}, []uint64{6, 8}, []uint64{5, 7}, 0, 5},
+		{"stale and duplicate", []uint64{4, 5, 5}, []uint64{5}, []uint64{}, 1, 6},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txs := make([]authtypes.StdTx, len(tc.seqs))
+			for i, seq := range tc.seqs {
+				txs[i] = makeTx(seq)
+			}
+
+			status := NewSequenceStatus(chainID, acc, txs)
+			require.Equal(t, addr, status.Address)
+			require.Equal(t, uint64(3), status.AccountNumber)
+			require.Equal(t, uint64(5), status.Sequence)
+			require.Equal(t, tc.pending, status.PendingSequences)
+			require.Equal(t, tc.gaps, status.Gaps)
+			require.Equal(t, tc.stale, status.StaleTxs)
+			require.Equal(t, tc.nextSeq, status.NextSequence)
+		})
+	}
+
+	// signatures for another chain are not matched
+	status := NewSequenceStatus("other-chain", acc, []authtypes.StdTx{makeTx(5)})
+	require.Empty(t, status.PendingSequences)
+	require.Equal(t, 1, status.StaleTxs)
+}
+
+func TestCheckReplacement(t *testing.T) {
+	const chainID = "test-chain"
+
+	priv, pub, addr := authtypes.KeyTestPubAddr()
+	acc := authtypes.NewBaseAccount(addr, pub, 3, 5)
+	fee := authtypes.NewTestStdFee()
+
+	makeTx := func(seq uint64) authtypes.StdTx {
+		msgs := []sdk.Msg{authtypes.NewTestMsg(addr)}
+		signBytes := authtypes.StdSignBytes(chainID, 3, seq, fee, msgs, "")
+		tx := authtypes.NewTestTxWithSignBytes(msgs, []crypto.PrivKey{priv}, []uint64{3}, []uint64{seq}, fee, signBytes, "")
+		return tx.(authtypes.StdTx)
+	}
+
+	// a transaction pending above a gap would be executed once the gap is filled
+	stuck := makeTx(6)
+	status := NewSequenceStatus(chainID, acc, []authtypes.StdTx{stuck})
+	require.Error(t, checkReplacement(chainID, acc, stuck, status.NextSequence, status))
+
+	// unless it is replaced with its own sequence
+	require.NoError(t, checkReplacement(chainID, acc, stuck, 6, status))
+
+	// a transaction valid beyond the pending ones is detected as well
+	status = NewSequenceStatus(chainID, acc, nil)
+	require.Error(t, checkReplacement(chainID, acc, makeTx(7), status.NextSequence, status))
+
+	// a transaction signed with a committed sequence can be replaced
+	require.NoError(t, checkReplacement(chainID, acc, makeTx(4), status.NextSequence, status))
+}
+
+func TestAdjustFees(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 1))
+
+	require.Equal(t, fees, adjustFees(fees, sdk.OneDec()))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 15), sdk.NewInt64Coin("stake", 2)),
+		adjustFees(fees, sdk.NewDecWithPrec(15, 1)),
+	)
+}
@@ -120,6 +120,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns no modules, since the genesis initialization of
+// the auth module only sets its params and accounts.
+func (AppModule) GenesisDependencies() []string { return nil }
+
 // ExportGenesis returns the exported genesis state as raw bytes for the auth
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
@@ -16,6 +16,10 @@ const (
 	StoreKey           = types.StoreKey
 	DefaultParamspace  = types.DefaultParamspace
 	DefaultSendEnabled = types.DefaultSendEnabled
+	QueryDenomOwners   = types.QueryDenomOwners
+
+	DefaultDenomOwnersLimit = types.DefaultDenomOwnersLimit
+	MaxDenomOwnersLimit     = types.MaxDenomOwnersLimit
 
 	EventTypeTransfer      = types.EventTypeTransfer
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
@@ -48,10 +52,14 @@ var (
 	ParamKeyTable               = types.ParamKeyTable
 	NewQueryBalanceParams       = types.NewQueryBalanceParams
 	NewQueryAllBalancesParams   = types.NewQueryAllBalancesParams
+	NewQueryDenomOwnersParams   = types.NewQueryDenomOwnersParams
+	NewDenomOwner               = types.NewDenomOwner
 	ModuleCdc                   = types.ModuleCdc
 	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
 	BalancesPrefix              = types.BalancesPrefix
 	AddressFromBalancesStore    = types.AddressFromBalancesStore
+	DenomOwnersPrefix           = types.DenomOwnersPrefix
+	DenomOwnersStoreKey         = types.DenomOwnersStoreKey
 )
 
 type (
@@ -70,4 +78,9 @@ type (
 	QueryBalanceParams      = types.QueryBalanceParams
 	QueryAllBalancesParams  = types.QueryAllBalancesParams
 	GenesisBalancesIterator = types.GenesisBalancesIterator
+	BankHooks               = types.BankHooks
+
+	DenomOwner               = types.DenomOwner
+	QueryDenomOwnersParams   = types.QueryDenomOwnersParams
+	QueryDenomOwnersResponse = types.QueryDenomOwnersResponse
 )
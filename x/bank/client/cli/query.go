@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/base64"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -15,7 +16,9 @@ import (
 )
 
 const (
-	flagDenom = "denom"
+	flagDenom   = "denom"
+	flagPageKey = "page-key"
+	flagLimit   = "limit"
 )
 
 // GetQueryCmd returns the parent querying command for the bank module.
@@ -28,7 +31,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetBalancesCmd(cdc))
+	cmd.AddCommand(
+		GetBalancesCmd(cdc),
+		GetDenomOwnersCmd(cdc),
+	)
 
 	return cmd
 }
@@ -97,3 +103,48 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// GetDenomOwnersCmd returns a CLI command handler that facilitates querying for
+// the accounts holding a denomination.
+func GetDenomOwnersCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query for the accounts holding a denomination",
+		Long: `Query for a page of the accounts holding a positive balance of a denomination,
+ordered by address. The next page is queried by passing the returned next key
+with the --page-key flag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			key, err := base64.StdEncoding.DecodeString(viper.GetString(flagPageKey))
+			if err != nil {
+				return fmt.Errorf("invalid page key: %w", err)
+			}
+
+			params := types.NewQueryDenomOwnersParams(args[0], key, viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomOwners)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDenomOwnersResponse
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	cmd.Flags().String(flagPageKey, "", "The base64 encoded key of the page to query for")
+	cmd.Flags().Int(flagLimit, types.DefaultDenomOwnersLimit, "The maximum number of accounts to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
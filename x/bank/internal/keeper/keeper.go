@@ -46,6 +46,15 @@ func NewBaseKeeper(
 	}
 }
 
+// SetHooks sets the hooks called on transfers between accounts.
+func (k *BaseKeeper) SetHooks(bh types.BankHooks) *BaseKeeper {
+	if k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+	k.hooks = bh
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -150,6 +159,7 @@ type BaseSendKeeper struct {
 	ak         types.AccountKeeper
 	storeKey   sdk.StoreKey
 	paramSpace params.Subspace
+	hooks      types.BankHooks
 
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
@@ -179,7 +189,21 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	var toAddrs []sdk.AccAddress
+	if k.hooks != nil {
+		toAddrs = make([]sdk.AccAddress, len(outputs))
+		for i, out := range outputs {
+			toAddrs[i] = out.Address
+		}
+	}
+
 	for _, in := range inputs {
+		if k.hooks != nil {
+			if err := k.hooks.BeforeSend(ctx, in.Address, toAddrs, in.Coins); err != nil {
+				return err
+			}
+		}
+
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
 			return err
@@ -226,6 +250,12 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
+	if k.hooks != nil {
+		if err := k.hooks.BeforeSend(ctx, fromAddr, []sdk.AccAddress{toAddr}, amt); err != nil {
+			return err
+		}
+	}
+
 	_, err := k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -312,6 +342,7 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 
 	for _, key := range keys {
 		accountStore.Delete(key)
+		k.denomOwnersStore(ctx, string(key)).Delete(addr)
 	}
 }
 
@@ -344,6 +375,13 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	bz := k.cdc.MustMarshalBinaryBare(balance)
 	accountStore.Set([]byte(balance.Denom), bz)
 
+	denomOwnersStore := k.denomOwnersStore(ctx, balance.Denom)
+	if balance.IsPositive() {
+		denomOwnersStore.Set(addr, []byte{})
+	} else {
+		denomOwnersStore.Delete(addr)
+	}
+
 	return nil
 }
 
@@ -381,6 +419,8 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+
+	GetDenomOwnersPaginated(ctx sdk.Context, denom string, key []byte, limit int) ([]types.DenomOwner, []byte)
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -481,6 +521,41 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 	}
 }
 
+// GetDenomOwnersPaginated returns up to limit accounts holding a positive
+// balance of the given denomination along with their balance, ordered by
+// address, starting at the given key. The returned key is passed to retrieve
+// the next page and is nil once the last page has been returned.
+func (k BaseViewKeeper) GetDenomOwnersPaginated(
+	ctx sdk.Context, denom string, key []byte, limit int,
+) (owners []types.DenomOwner, nextKey []byte) {
+
+	if len(key) == 0 {
+		key = nil
+	}
+
+	iterator := k.denomOwnersStore(ctx, denom).Iterator(key, nil)
+	defer iterator.Close()
+
+	owners = []types.DenomOwner{}
+	for ; iterator.Valid(); iterator.Next() {
+		if len(owners) == limit {
+			return owners, iterator.Key()
+		}
+
+		addr := sdk.AccAddress(iterator.Key())
+		owners = append(owners, types.NewDenomOwner(addr, k.GetBalance(ctx, addr, denom)))
+	}
+
+	return owners, nil
+}
+
+// denomOwnersStore returns the store indexing the addresses of the accounts
+// holding a positive balance of the given denomination.
+func (k BaseViewKeeper) denomOwnersStore(ctx sdk.Context, denom string) sdk.KVStore {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomOwnersPrefix)
+	return prefix.NewStore(store, types.DenomOwnersStoreKey(denom))
+}
+
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
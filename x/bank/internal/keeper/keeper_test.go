@@ -206,6 +206,42 @@ func (suite *IntegrationTestSuite) TestBalance() {
 	suite.Require().Error(app.BankKeeper.SetBalance(ctx, addr, invalidBalance))
 }
 
+func (suite *IntegrationTestSuite) TestDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins(newFooCoin(30), newBarCoin(5))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foo2", 1))))
+
+	owners, nextKey := app.BankKeeper.GetDenomOwnersPaginated(ctx, fooDenom, nil, 10)
+	suite.Require().Nil(nextKey)
+	suite.Require().Equal([]types.DenomOwner{
+		types.NewDenomOwner(addr2, newFooCoin(20)),
+		types.NewDenomOwner(addr3, newFooCoin(30)),
+	}, owners)
+
+	owners, nextKey = app.BankKeeper.GetDenomOwnersPaginated(ctx, fooDenom, nil, 1)
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addr2, newFooCoin(20))}, owners)
+	owners, nextKey = app.BankKeeper.GetDenomOwnersPaginated(ctx, fooDenom, nextKey, 1)
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addr3, newFooCoin(30))}, owners)
+	suite.Require().Nil(nextKey)
+
+	// accounts are no longer indexed once their balance is zero
+	_, err := app.BankKeeper.SubtractCoins(ctx, addr2, sdk.NewCoins(newFooCoin(20)))
+	suite.Require().NoError(err)
+	owners, _ = app.BankKeeper.GetDenomOwnersPaginated(ctx, fooDenom, nil, 10)
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addr3, newFooCoin(30))}, owners)
+
+	owners, _ = app.BankKeeper.GetDenomOwnersPaginated(ctx, "foo2", nil, 10)
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addr1, sdk.NewInt64Coin("foo2", 1))}, owners)
+	owners, _ = app.BankKeeper.GetDenomOwnersPaginated(ctx, "baz", nil, 10)
+	suite.Require().Empty(owners)
+}
+
 func (suite *IntegrationTestSuite) TestSendEnabled() {
 	app, ctx := suite.app, suite.ctx
 	enabled := false
@@ -19,6 +19,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAllBalances:
 			return queryAllBalance(ctx, req, k)
 
+		case types.QueryDenomOwners:
+			return queryDenomOwners(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -58,3 +61,32 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 
 	return bz, nil
 }
+
+func queryDenomOwners(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomOwnersParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	limit := params.Limit
+	switch {
+	case limit <= 0:
+		limit = types.DefaultDenomOwnersLimit
+	case limit > types.MaxDenomOwnersLimit:
+		limit = types.MaxDenomOwnersLimit
+	}
+
+	owners, nextKey := k.GetDenomOwnersPaginated(ctx, params.Denom, params.Key, limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryDenomOwnersResponse{Owners: owners, NextKey: nextKey})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -83,6 +83,50 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 	suite.True(balances.IsEqual(origCoins))
 }
 
+func (suite *IntegrationTestSuite) TestQuerier_QueryDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDenomOwners),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersParams("f", nil, 0))
+	_, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().Error(err)
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+	for _, addr := range addrs {
+		suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(newFooCoin(50))))
+	}
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersParams(fooDenom, nil, 1))
+	res, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NoError(err)
+
+	var page types.QueryDenomOwnersResponse
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &page))
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addrs[0], newFooCoin(50))}, page.Owners)
+	suite.Require().NotNil(page.NextKey)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersParams(fooDenom, page.NextKey, 1))
+	res, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NoError(err)
+
+	page = types.QueryDenomOwnersResponse{}
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &page))
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addrs[1], newFooCoin(50))}, page.Owners)
+	suite.Require().Nil(page.NextKey)
+}
+
 func (suite *IntegrationTestSuite) TestQuerierRouteNotFound() {
 	app, ctx := suite.app, suite.ctx
 	req := abci.RequestQuery{
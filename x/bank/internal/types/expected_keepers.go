@@ -16,3 +16,11 @@ type AccountKeeper interface {
 
 	IterateAccounts(ctx sdk.Context, process func(exported.Account) bool)
 }
+
+// BankHooks defines the hooks called by the x/bank keeper on transfers between
+// accounts.
+type BankHooks interface {
+	// BeforeSend is called before amt is sent from fromAddr to the recipients.
+	// The transfer fails if an error is returned.
+	BeforeSend(ctx sdk.Context, fromAddr sdk.AccAddress, toAddrs []sdk.AccAddress, amt sdk.Coins) error
+}
@@ -22,7 +22,8 @@ const (
 
 // KVStore key prefixes
 var (
-	BalancesPrefix = []byte("balances")
+	BalancesPrefix    = []byte("balances")
+	DenomOwnersPrefix = []byte("denom_owners")
 )
 
 // AddressFromBalancesStore returns an account address from a balances prefix
@@ -36,3 +37,12 @@ func AddressFromBalancesStore(key []byte) sdk.AccAddress {
 
 	return sdk.AccAddress(addr)
 }
+
+// DenomOwnersStoreKey returns the key prefix under which the addresses holding
+// a positive balance of the given denomination are stored, relative to the
+// prefix DenomOwnersPrefix. Denominations cannot contain zero bytes, so the key
+// is terminated with one to prevent a denomination from being a prefix of
+// another.
+func DenomOwnersStoreKey(denom string) []byte {
+	return append([]byte(denom), 0)
+}
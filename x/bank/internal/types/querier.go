@@ -8,6 +8,12 @@ import (
 const (
 	QueryBalance     = "balance"
 	QueryAllBalances = "all_balances"
+	QueryDenomOwners = "denom_owners"
+
+	// DefaultDenomOwnersLimit is the number of owners returned by a denom owners
+	// query not specifying a limit, MaxDenomOwnersLimit the maximum number.
+	DefaultDenomOwnersLimit = 100
+	MaxDenomOwnersLimit     = 1000
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -30,3 +36,36 @@ type QueryAllBalancesParams struct {
 func NewQueryAllBalancesParams(addr sdk.AccAddress) QueryAllBalancesParams {
 	return QueryAllBalancesParams{Address: addr}
 }
+
+// QueryDenomOwnersParams defines the params for querying the accounts holding a
+// denomination. Key is the key of the page to return, or empty for the first
+// page, and Limit the maximum number of owners to return.
+type QueryDenomOwnersParams struct {
+	Denom string
+	Key   []byte
+	Limit int
+}
+
+// NewQueryDenomOwnersParams creates a new instance of QueryDenomOwnersParams.
+func NewQueryDenomOwnersParams(denom string, key []byte, limit int) QueryDenomOwnersParams {
+	return QueryDenomOwnersParams{Denom: denom, Key: key, Limit: limit}
+}
+
+// DenomOwner defines an account holding a positive balance of a denomination.
+type DenomOwner struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Balance sdk.Coin       `json:"balance" yaml:"balance"`
+}
+
+// NewDenomOwner creates a new instance of DenomOwner.
+func NewDenomOwner(addr sdk.AccAddress, balance sdk.Coin) DenomOwner {
+	return DenomOwner{Address: addr, Balance: balance}
+}
+
+// QueryDenomOwnersResponse defines a page of the accounts holding a
+// denomination. NextKey is the key of the next page, or nil if there are no more
+// owners.
+type QueryDenomOwnersResponse struct {
+	Owners  []DenomOwner `json:"owners" yaml:"owners"`
+	NextKey []byte       `json:"next_key" yaml:"next_key"`
+}
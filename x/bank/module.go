@@ -25,6 +25,7 @@ import (
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.HasIndexedEvents    = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 )
 
@@ -68,6 +69,14 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(cdc)
 }
 
+// IndexedEvents returns the event attributes of the bank module to index.
+func (AppModuleBasic) IndexedEvents() []string {
+	return []string{
+		fmt.Sprintf("%s.%s", types.EventTypeTransfer, types.AttributeKeyRecipient),
+		fmt.Sprintf("%s.%s", types.EventTypeTransfer, types.AttributeKeySender),
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the bank module.
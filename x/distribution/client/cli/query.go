@@ -72,7 +72,8 @@ func GetCmdQueryValidatorOutstandingRewards(queryRoute string, cdc *codec.Codec)
 		Short: "Query distribution outstanding (un-withdrawn) rewards for a validator and all their delegations",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query distribution outstanding (un-withdrawn) rewards
-for a validator and all their delegations.
+for a validator and all their delegations. The validator may be given by either
+its operator address or the account address of its operator.
 
 Example:
 $ %s query distribution validator-outstanding-rewards cosmosvaloper1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne
@@ -83,7 +84,7 @@ $ %s query distribution validator-outstanding-rewards cosmosvaloper1lwjmdnks33xw
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -119,7 +120,9 @@ func GetCmdQueryValidatorCommission(queryRoute string, cdc *codec.Codec) *cobra.
 		Args:  cobra.ExactArgs(1),
 		Short: "Query distribution validator commission",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query validator commission rewards from delegators to that validator.
+			fmt.Sprintf(`Query validator commission rewards from delegators to that validator. The
+validator may be given by either its operator address or the account address of
+its operator.
 
 Example:
 $ %s query distribution commission cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
@@ -130,7 +133,7 @@ $ %s query distribution commission cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9l
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -154,7 +157,8 @@ func GetCmdQueryValidatorSlashes(queryRoute string, cdc *codec.Codec) *cobra.Com
 		Args:  cobra.ExactArgs(3),
 		Short: "Query distribution validator slashes",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query all slashes of a validator for a given block range.
+			fmt.Sprintf(`Query all slashes of a validator for a given block range. The validator may be
+given by either its operator address or the account address of its operator.
 
 Example:
 $ %s query distribution slashes cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 0 100
@@ -165,7 +169,7 @@ $ %s query distribution slashes cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmq
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -16,7 +16,7 @@ func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute, delAddr, valA
 		return nil, 0, err
 	}
 
-	validatorAddr, err := sdk.ValAddressFromBech32(valAddr)
+	validatorAddr, err := sdk.ValAddressFromAccOrValBech32(valAddr)
 	if err != nil {
 		return nil, 0, err
 	}
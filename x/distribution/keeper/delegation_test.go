@@ -648,3 +648,73 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestTransferDelegationRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// second delegation
+	delAddr, newDelAddr := sdk.AccAddress(valOpAddr2), delAddr1
+	msg2 := staking.NewMsgDelegate(delAddr, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	res, err = sh(ctx, msg2)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := int64(20)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial)}}
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	// transfer the second delegation
+	balance := bk.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	res, err = sh(ctx, staking.NewMsgEditDelegation(delAddr, valOpAddr1, newDelAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the rewards accrued so far are withdrawn to the previous delegator
+	require.Equal(t,
+		balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(initial/4))),
+		bk.GetBalance(ctx, delAddr, sdk.DefaultBondDenom),
+	)
+	require.False(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, delAddr))
+	require.True(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, newDelAddr))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some more rewards
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	// end period
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+
+	// the new delegator accrues the rewards from the transfer onwards
+	del := sk.Delegation(ctx, newDelAddr, valOpAddr1)
+	rewards := k.calculateDelegationRewards(ctx, val, del, endingPeriod)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial / 4)}}, rewards)
+}
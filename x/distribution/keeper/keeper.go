@@ -167,9 +167,35 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.
 		return err
 	}
 
+	k.addToCommunityPool(ctx, amount, sender.String())
+	return nil
+}
+
+// FundCommunityPoolFromModule allows a module to directly fund the community
+// fund pool from its module account, e.g. to route burned tokens or penalties
+// to the pool. An error is returned if the amount cannot be sent to the
+// distribution module account.
+func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, amount sdk.Coins, senderModule string) error {
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	k.addToCommunityPool(ctx, amount, k.supplyKeeper.GetModuleAddress(senderModule).String())
+	return nil
+}
+
+// addToCommunityPool adds an amount already held by the distribution module
+// account to the community pool.
+func (k Keeper) addToCommunityPool(ctx sdk.Context, amount sdk.Coins, depositor string) {
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
 	k.SetFeePool(ctx, feePool)
 
-	return nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundCommunityPool,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor),
+		),
+	)
 }
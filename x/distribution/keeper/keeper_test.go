@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -111,3 +113,28 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), keeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, bk.GetAllBalances(ctx, delAddr1))
 }
+
+func TestFundCommunityPoolFromModule(t *testing.T) {
+	// nolint dogsled
+	ctx, _, bk, keeper, _, _, supplyKeeper := CreateTestInputAdvanced(t, false, 1000, sdk.NewDecWithPrec(2, 2))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	feeCollector := supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.NoError(t, bk.SetBalances(ctx, feeCollector.GetAddress(), amount))
+
+	initPool := keeper.GetFeePool(ctx)
+	assert.Empty(t, initPool.CommunityPool)
+
+	err := keeper.FundCommunityPoolFromModule(ctx, amount, auth.FeeCollectorName)
+	require.NoError(t, err)
+
+	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), keeper.GetFeePool(ctx).CommunityPool)
+	assert.Empty(t, bk.GetAllBalances(ctx, feeCollector.GetAddress()))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeFundCommunityPool, events[len(events)-1].Type)
+
+	// the module account cannot fund more than it holds
+	err = keeper.FundCommunityPoolFromModule(ctx, amount, auth.FeeCollectorName)
+	require.Error(t, err)
+}
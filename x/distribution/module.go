@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
@@ -25,6 +26,7 @@ import (
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.HasIndexedEvents    = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 )
 
@@ -72,6 +74,15 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(StoreKey, cdc)
 }
 
+// IndexedEvents returns the event attributes of the distribution module to index.
+func (AppModuleBasic) IndexedEvents() []string {
+	return []string{
+		fmt.Sprintf("%s.%s", types.EventTypeWithdrawRewards, types.AttributeKeyValidator),
+		fmt.Sprintf("%s.%s", types.EventTypeSetWithdrawAddress, types.AttributeKeyWithdrawAddress),
+		fmt.Sprintf("%s.%s", types.EventTypeFundCommunityPool, types.AttributeKeyDepositor),
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the distribution module.
@@ -139,6 +150,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns the auth module, whose accounts store the
+// distribution module account.
+func (AppModule) GenesisDependencies() []string {
+	return []string{authtypes.ModuleName}
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the distribution
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
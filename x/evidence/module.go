@@ -18,8 +18,9 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule              = AppModule{}
+	_ module.AppModuleBasic         = AppModuleBasic{}
+	_ module.HasGenesisDependencies = AppModule{}
 
 	// TODO: Enable simulation once concrete types are defined.
 	// _ module.AppModuleSimulation = AppModuleSimulation{}
@@ -151,6 +152,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, bz json.RawMessage) []abci.Vali
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns no modules, since the genesis initialization of
+// the evidence module only sets its evidence and params.
+func (AppModule) GenesisDependencies() []string { return nil }
+
 // ExportGenesis returns the evidence module's exported genesis state as raw JSON bytes.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return ModuleCdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
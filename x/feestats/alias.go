@@ -0,0 +1,52 @@
+package feestats
+
+// nolint
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	DefaultParamspace        = types.DefaultParamspace
+	DefaultWindow            = types.DefaultWindow
+	QueryParameters          = types.QueryParameters
+	QueryGasPricePercentiles = types.QueryGasPricePercentiles
+)
+
+var (
+	// functions aliases
+	NewKeeper                         = keeper.NewKeeper
+	NewQuerier                        = keeper.NewQuerier
+	RegisterCodec                     = types.RegisterCodec
+	NewGenesisState                   = types.NewGenesisState
+	DefaultGenesisState               = types.DefaultGenesisState
+	NewParams                         = types.NewParams
+	DefaultParams                     = types.DefaultParams
+	ParamKeyTable                     = types.ParamKeyTable
+	NewGasPriceRecord                 = types.NewGasPriceRecord
+	NewGasPricePercentiles            = types.NewGasPricePercentiles
+	NewQueryGasPricePercentilesParams = types.NewQueryGasPricePercentilesParams
+	GetGasPriceHeightKey              = types.GetGasPriceHeightKey
+	GetGasPriceKey                    = types.GetGasPriceKey
+	SplitGasPriceKey                  = types.SplitGasPriceKey
+
+	// variable aliases
+	ModuleCdc         = types.ModuleCdc
+	KeyWindow         = types.KeyWindow
+	GasPriceKeyPrefix = types.GasPriceKeyPrefix
+	ErrNoGasPrices    = types.ErrNoGasPrices
+)
+
+type (
+	Keeper                         = keeper.Keeper
+	GenesisState                   = types.GenesisState
+	Params                         = types.Params
+	GasPriceRecord                 = types.GasPriceRecord
+	GasPricePercentiles            = types.GasPricePercentiles
+	QueryGasPricePercentilesParams = types.QueryGasPricePercentilesParams
+)
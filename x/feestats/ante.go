@@ -0,0 +1,47 @@
+package feestats
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// RecordGasPriceDecorator records the effective gas prices of the transactions
+// delivered in a block once the rest of the ante handler has succeeded. The
+// gas prices are recorded with an infinite gas meter, so that recording them
+// does not change the gas consumed by the transactions.
+//
+// CONTRACT: Tx must implement FeeTx interface to use RecordGasPriceDecorator
+type RecordGasPriceDecorator struct {
+	k Keeper
+}
+
+func NewRecordGasPriceDecorator(k Keeper) RecordGasPriceDecorator {
+	return RecordGasPriceDecorator{k: k}
+}
+
+func (rgpd RecordGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil || ctx.IsCheckTx() || simulate || ctx.TxHash() == nil {
+		return newCtx, err
+	}
+
+	recordCtx := newCtx
+	if recordCtx.IsZero() {
+		recordCtx = ctx
+	}
+
+	if feeTx, ok := tx.(ante.FeeTx); ok {
+		rgpd.k.RecordGasPrices(recordCtx.WithGasMeter(sdk.NewInfiniteGasMeter()), ctx.TxHash(), feeTx.GetFee(), feeTx.GetGas())
+	}
+
+	return newCtx, nil
+}
+
+// NewAnteHandler returns an AnteHandler which runs anteHandler and records the
+// effective gas prices of the transactions it accepts in DeliverTx.
+func NewAnteHandler(anteHandler sdk.AnteHandler, k Keeper) sdk.AnteHandler {
+	rgpd := NewRecordGasPriceDecorator(k)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return rgpd.AnteHandle(ctx, tx, simulate, anteHandler)
+	}
+}
@@ -0,0 +1,59 @@
+package feestats_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feestats"
+)
+
+type feeTx struct {
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg       { return nil }
+func (tx feeTx) ValidateBasic() error     { return nil }
+func (tx feeTx) GetGas() uint64           { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins        { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress { return nil }
+
+func TestRecordGasPriceDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1}).
+		WithTxHash([]byte{0x01}).
+		WithGasMeter(sdk.NewGasMeter(1000))
+
+	accept := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	reject := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, errors.New("rejected")
+	}
+
+	tx := feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), gas: 100}
+
+	// rejected, checked and simulated transactions are not recorded
+	_, err := feestats.NewAnteHandler(reject, app.FeeStatsKeeper)(ctx, tx, false)
+	require.Error(t, err)
+	_, err = feestats.NewAnteHandler(accept, app.FeeStatsKeeper)(ctx.WithIsCheckTx(true), tx, false)
+	require.NoError(t, err)
+	_, err = feestats.NewAnteHandler(accept, app.FeeStatsKeeper)(ctx, tx, true)
+	require.NoError(t, err)
+
+	queryCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = app.FeeStatsKeeper.GetGasPricePercentiles(queryCtx, "stake")
+	require.Error(t, err)
+
+	_, err = feestats.NewAnteHandler(accept, app.FeeStatsKeeper)(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+
+	p, err := app.FeeStatsKeeper.GetGasPricePercentiles(queryCtx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), p.Txs)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), p.P50)
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/types"
+)
+
+// GetQueryCmd returns the query commands for the feestats module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feestats module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryGasPrices(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryParams returns the command to query the feestats parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current feestats parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryGasPrices returns the command to query the percentiles of the
+// gas prices paid in a denomination by the transactions of the recent blocks.
+func GetCmdQueryGasPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "gas-prices [denom]",
+		Short: "Query the 10th, 50th and 90th percentiles of the recent gas prices of a denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryGasPricePercentilesParams(args[0]))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryGasPricePercentiles)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var percentiles types.GasPricePercentiles
+			if err := cdc.UnmarshalJSON(res, &percentiles); err != nil {
+				return fmt.Errorf("failed to unmarshal gas price percentiles: %w", err)
+			}
+
+			return cliCtx.PrintOutput(percentiles)
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package feestats
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the feestats module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, r := range gs.GasPrices {
+		k.SetGasPriceRecord(ctx, r)
+	}
+}
+
+// ExportGenesis returns the feestats module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	gasPrices := []GasPriceRecord{}
+	k.IterateGasPriceRecords(ctx, func(r GasPriceRecord) bool {
+		gasPrices = append(gasPrices, r)
+		return false
+	})
+
+	return NewGenesisState(k.GetParams(ctx), gasPrices)
+}
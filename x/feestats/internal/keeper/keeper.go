@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keeper defines the feestats module's keeper. It keeps the effective gas
+// prices of the transactions delivered in the recent blocks.
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace params.Subspace
+}
+
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetGasPriceRecord sets the gas prices of a transaction.
+func (k Keeper) SetGasPriceRecord(ctx sdk.Context, r types.GasPriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGasPriceKey(r.Height, r.TxHash), k.cdc.MustMarshalBinaryBare(r.GasPrices))
+}
+
+// IterateGasPriceRecords iterates over the gas prices of all transactions kept,
+// ordered by height. If true is returned from the callback, iteration is halted.
+func (k Keeper) IterateGasPriceRecords(ctx sdk.Context, cb func(types.GasPriceRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GasPriceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var gasPrices sdk.DecCoins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &gasPrices)
+
+		height, txHash := types.SplitGasPriceKey(iterator.Key())
+		r := types.NewGasPriceRecord(height, txHash, gasPrices)
+		if cb(r) {
+			break
+		}
+	}
+}
+
+// RecordGasPrices records the effective gas prices of a transaction delivered
+// in the current block, i.e. its fee divided by its gas limit. Transactions
+// without fee or gas limit are not recorded.
+func (k Keeper) RecordGasPrices(ctx sdk.Context, txHash []byte, fee sdk.Coins, gas uint64) {
+	if fee.IsZero() || gas == 0 {
+		return
+	}
+
+	gasPrices := sdk.NewDecCoinsFromCoins(fee...).QuoDec(sdk.NewDecFromInt(sdk.NewIntFromUint64(gas)))
+	k.SetGasPriceRecord(ctx, types.NewGasPriceRecord(ctx.BlockHeight(), txHash, gasPrices))
+}
+
+// GasPriceRetentionPolicy returns the retention policy of the gas prices, which
+// retains the gas prices of the transactions of the Window most recent blocks.
+func (k Keeper) GasPriceRetentionPolicy() sdk.RetentionPolicy {
+	return sdk.NewHeightRetentionPolicy(
+		types.ModuleName+"/gas_prices", k.storeKey, types.GasPriceKeyPrefix,
+		func(ctx sdk.Context) int64 { return int64(k.Window(ctx)) },
+	)
+}
+
+// GetGasPricePercentiles returns the percentiles of the gas prices paid in a
+// denomination by the transactions of the recent blocks.
+func (k Keeper) GetGasPricePercentiles(ctx sdk.Context, denom string) (types.GasPricePercentiles, error) {
+	var gasPrices []sdk.Dec
+	k.IterateGasPriceRecords(ctx, func(r types.GasPriceRecord) bool {
+		if gasPrice := r.GasPrices.AmountOf(denom); gasPrice.IsPositive() {
+			gasPrices = append(gasPrices, gasPrice)
+		}
+		return false
+	})
+
+	if len(gasPrices) == 0 {
+		return types.GasPricePercentiles{}, sdkerrors.Wrap(types.ErrNoGasPrices, denom)
+	}
+
+	return types.NewGasPricePercentiles(denom, k.Window(ctx), gasPrices), nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/types"
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.FeeStatsKeeper.SetParams(ctx, types.NewParams(2))
+
+	return app, ctx
+}
+
+func TestRecordGasPrices(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.FeeStatsKeeper
+
+	k.RecordGasPrices(ctx, []byte{0x01}, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 100)
+	k.RecordGasPrices(ctx, []byte{0x02}, sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 5)), 100)
+
+	// transactions without fee or gas limit are not recorded
+	k.RecordGasPrices(ctx, []byte{0x03}, sdk.NewCoins(), 100)
+	k.RecordGasPrices(ctx, []byte{0x04}, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0)
+
+	ctx = ctx.WithBlockHeight(2)
+	k.RecordGasPrices(ctx, []byte{0x05}, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), 100)
+
+	p, err := k.GetGasPricePercentiles(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), p.Txs)
+	require.Equal(t, uint64(2), p.Window)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), p.P10)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), p.P50)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), p.P90)
+
+	p, err = k.GetGasPricePercentiles(ctx, "atom")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), p.Txs)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), p.P50)
+
+	_, err = k.GetGasPricePercentiles(ctx, "photon")
+	require.True(t, types.ErrNoGasPrices.Is(err))
+
+	// the gas prices of the first block are pruned at the end of the third
+	pm := module.NewPruningManager()
+	pm.RegisterRetentionPolicy(k.GasPriceRetentionPolicy())
+	pm.EndBlock(ctx)
+	p, err = k.GetGasPricePercentiles(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), p.Txs)
+
+	pm.EndBlock(ctx.WithBlockHeight(3))
+	p, err = k.GetGasPricePercentiles(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), p.Txs)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), p.P50)
+
+	_, err = k.GetGasPricePercentiles(ctx, "atom")
+	require.Error(t, err)
+}
+
+func TestQueryGasPricePercentiles(t *testing.T) {
+	app, ctx := createTestApp()
+	querier := keeper.NewQuerier(app.FeeStatsKeeper)
+
+	app.FeeStatsKeeper.RecordGasPrices(ctx, []byte{0x01}, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 100)
+
+	bz, err := app.Codec().MarshalJSON(types.NewQueryGasPricePercentilesParams("stake"))
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{types.QueryGasPricePercentiles}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var p types.GasPricePercentiles
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &p))
+	require.Equal(t, uint64(1), p.Txs)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), p.P90)
+
+	bz, err = app.Codec().MarshalJSON(types.NewQueryGasPricePercentilesParams("atom"))
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{types.QueryGasPricePercentiles}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feestats/internal/types"
+)
+
+// Window returns the number of recent blocks whose gas prices are kept.
+func (k Keeper) Window(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyWindow, &res)
+	return
+}
+
+// GetParams returns the total set of feestats parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the feestats parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
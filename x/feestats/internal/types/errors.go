@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feestats module sentinel errors
+var (
+	ErrNoGasPrices = sdkerrors.Register(ModuleName, 1, "no gas prices recorded")
+)
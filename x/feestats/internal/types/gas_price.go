@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasPriceRecord defines the effective gas prices of a transaction delivered in
+// a block, i.e. its fee divided by its gas limit.
+type GasPriceRecord struct {
+	Height    int64            `json:"height" yaml:"height"`
+	TxHash    tmbytes.HexBytes `json:"tx_hash" yaml:"tx_hash"`
+	GasPrices sdk.DecCoins     `json:"gas_prices" yaml:"gas_prices"`
+}
+
+func NewGasPriceRecord(height int64, txHash []byte, gasPrices sdk.DecCoins) GasPriceRecord {
+	return GasPriceRecord{
+		Height:    height,
+		TxHash:    txHash,
+		GasPrices: gasPrices,
+	}
+}
+
+// Validate performs basic validation of the gas price record.
+func (r GasPriceRecord) Validate() error {
+	if r.Height <= 0 {
+		return fmt.Errorf("invalid height of gas prices of tx %s: %d", r.TxHash, r.Height)
+	}
+	if len(r.TxHash) == 0 {
+		return errors.New("gas prices have no tx hash")
+	}
+	if !r.GasPrices.IsValid() {
+		return fmt.Errorf("invalid gas prices of tx %s: %s", r.TxHash, r.GasPrices)
+	}
+
+	return nil
+}
+
+func (r GasPriceRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// GasPricePercentiles defines the 10th, 50th and 90th percentiles of the gas
+// prices paid in a denomination by the transactions of the recent blocks.
+type GasPricePercentiles struct {
+	Denom  string  `json:"denom" yaml:"denom"`
+	Window uint64  `json:"window" yaml:"window"`
+	Txs    uint64  `json:"txs" yaml:"txs"`
+	P10    sdk.Dec `json:"p10" yaml:"p10"`
+	P50    sdk.Dec `json:"p50" yaml:"p50"`
+	P90    sdk.Dec `json:"p90" yaml:"p90"`
+}
+
+// NewGasPricePercentiles returns the percentiles of the given non-empty set of
+// gas prices, using the nearest rank method.
+func NewGasPricePercentiles(denom string, window uint64, gasPrices []sdk.Dec) GasPricePercentiles {
+	sorted := make([]sdk.Dec, len(gasPrices))
+	copy(sorted, gasPrices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	return GasPricePercentiles{
+		Denom:  denom,
+		Window: window,
+		Txs:    uint64(len(sorted)),
+		P10:    percentile(sorted, 10),
+		P50:    percentile(sorted, 50),
+		P90:    percentile(sorted, 90),
+	}
+}
+
+func (p GasPricePercentiles) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// percentile returns the p-th percentile of sorted gas prices, i.e. the
+// smallest gas price which is greater than or equal to p percent of them.
+func percentile(sorted []sdk.Dec, p int) sdk.Dec {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
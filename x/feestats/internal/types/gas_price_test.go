@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewGasPricePercentiles(t *testing.T) {
+	gasPrices := make([]sdk.Dec, 20)
+	for i := range gasPrices {
+		// 20, 19, ..., 1
+		gasPrices[i] = sdk.NewDec(int64(len(gasPrices) - i))
+	}
+
+	p := NewGasPricePercentiles("stake", 10, gasPrices)
+	require.Equal(t, uint64(20), p.Txs)
+	require.Equal(t, sdk.NewDec(2), p.P10)
+	require.Equal(t, sdk.NewDec(10), p.P50)
+	require.Equal(t, sdk.NewDec(18), p.P90)
+
+	// the gas prices are left unsorted
+	require.Equal(t, sdk.NewDec(20), gasPrices[0])
+
+	p = NewGasPricePercentiles("stake", 10, []sdk.Dec{sdk.NewDec(5)})
+	require.Equal(t, sdk.NewDec(5), p.P10)
+	require.Equal(t, sdk.NewDec(5), p.P50)
+	require.Equal(t, sdk.NewDec(5), p.P90)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+	r := NewGasPriceRecord(1, []byte{0x01}, gasPrices)
+
+	require.NoError(t, DefaultGenesisState().Validate())
+	require.NoError(t, NewGenesisState(DefaultParams(), []GasPriceRecord{r}).Validate())
+	require.Error(t, NewGenesisState(NewParams(0), nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), []GasPriceRecord{r, r}).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), []GasPriceRecord{NewGasPriceRecord(0, []byte{0x01}, gasPrices)}).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), []GasPriceRecord{NewGasPriceRecord(1, nil, gasPrices)}).Validate())
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the feestats module's genesis state.
+type GenesisState struct {
+	Params    Params           `json:"params" yaml:"params"`
+	GasPrices []GasPriceRecord `json:"gas_prices" yaml:"gas_prices"`
+}
+
+func NewGenesisState(p Params, gasPrices []GasPriceRecord) GenesisState {
+	return GenesisState{
+		Params:    p,
+		GasPrices: gasPrices,
+	}
+}
+
+// DefaultGenesisState returns the feestats module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []GasPriceRecord{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	txs := make(map[string]bool, len(gs.GasPrices))
+	for _, r := range gs.GasPrices {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetGasPriceKey(r.Height, r.TxHash))
+		if txs[key] {
+			return fmt.Errorf("duplicate gas prices of tx %s at height %d", r.TxHash, r.Height)
+		}
+		txs[key] = true
+	}
+
+	return nil
+}
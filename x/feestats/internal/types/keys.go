@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feestats"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	GasPriceKeyPrefix = []byte{0x01}
+)
+
+// GetGasPriceHeightKey returns the key prefix of the gas prices of the
+// transactions of a block.
+func GetGasPriceHeightKey(height int64) []byte {
+	return append(GasPriceKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetGasPriceKey returns the key of the gas prices of a transaction.
+func GetGasPriceKey(height int64, txHash []byte) []byte {
+	return append(GetGasPriceHeightKey(height), txHash...)
+}
+
+// SplitGasPriceKey returns the height and the tx hash of the key of the gas
+// prices of a transaction.
+func SplitGasPriceKey(key []byte) (height int64, txHash []byte) {
+	return int64(binary.BigEndian.Uint64(key[1:9])), key[9:]
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameter values
+const (
+	DefaultParamspace        = ModuleName
+	DefaultWindow     uint64 = 100
+)
+
+// Parameter store keys
+var (
+	KeyWindow = []byte("Window")
+)
+
+// Params defines the total set of parameters for the feestats module
+type Params struct {
+	// Window is the number of recent blocks whose gas prices are kept.
+	Window uint64 `json:"window" yaml:"window"`
+}
+
+// NewParams creates a new Params object
+func NewParams(window uint64) Params {
+	return Params{
+		Window: window,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyWindow, &p.Window, validateWindow),
+	}
+}
+
+// DefaultParams returns the default parameters for the feestats module.
+func DefaultParams() Params {
+	return NewParams(DefaultWindow)
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	return validateWindow(p.Window)
+}
+
+func validateWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("window must be positive: %d", v)
+	}
+
+	return nil
+}
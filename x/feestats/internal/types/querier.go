@@ -0,0 +1,17 @@
+package types
+
+// Querier routes for the feestats module
+const (
+	QueryParameters          = "parameters"
+	QueryGasPricePercentiles = "gas_price_percentiles"
+)
+
+// QueryGasPricePercentilesParams defines the parameters necessary for querying
+// the percentiles of the recent gas prices of a denomination.
+type QueryGasPricePercentilesParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+func NewQueryGasPricePercentilesParams(denom string) QueryGasPricePercentilesParams {
+	return QueryGasPricePercentilesParams{Denom: denom}
+}
@@ -9,7 +9,7 @@ now exists under the x/auth module's genesis state under the `app_state.auth.acc
 Migration can be performed via x/auth/legacy/v0_38/migrate.go. In addition, because genesis
 accounts are now generalized via an interface, it is now up to the application to
 define the concrete types and the respective client logic to add them to a genesis
-state/file. For an example implementation of the `add-genesis-account` command please
-refer to https://github.com/cosmos/gaia/pull/122.
+state/file. The `add-genesis-account` command of x/genutil/client/cli supports base,
+vesting and module accounts.
 */
 package genaccounts
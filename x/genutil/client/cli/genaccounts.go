@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+const (
+	flagVestingStart      = "vesting-start-time"
+	flagVestingEnd        = "vesting-end-time"
+	flagVestingAmt        = "vesting-amount"
+	flagModuleAccount     = "module-account"
+	flagModulePermissions = "module-permissions"
+)
+
+// AddGenesisAccountCmd returns a command that adds a genesis account, and its
+// balance, to the genesis file. Adding an account at an existing address merges
+// the balances instead of failing, so that the command can be used to patch an
+// existing genesis file.
+func AddGenesisAccountCmd(
+	ctx *server.Context, cdc *codec.Codec, defaultNodeHome, defaultClientHome string,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "add-genesis-account [address_or_key_name] [coin][,[coin]]",
+		Short: "Add a genesis account to genesis.json",
+		Long: `Add a genesis account to genesis.json. The provided account must specify
+the account address or key name and a list of initial coins. If a key name is given,
+the address will be looked up in the local keyring. The list of initial tokens must
+contain valid denominations.
+
+Accounts may optionally be supplied with vesting parameters. With --module-account
+the first argument is the name of a module and a module account is added instead.
+
+If an account already exists at the address, the coins are added to its balance
+and the account itself is left unchanged. The total supply of the genesis file, if
+set, is updated accordingly.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse coins: %w", err)
+			}
+
+			var genAccount authexported.GenesisAccount
+			if viper.GetBool(flagModuleAccount) {
+				genAccount, err = newModuleGenesisAccount(args[0], viper.GetString(flagModulePermissions))
+			} else {
+				var addr sdk.AccAddress
+				addr, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					addr, err = lookupKeyAddress(cmd, args[0])
+					if err != nil {
+						return err
+					}
+				}
+
+				genAccount, err = newGenesisAccount(addr, coins)
+			}
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			if err := addGenesisAccount(cdc, appState, genAccount, coins); err != nil {
+				return err
+			}
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().Bool(flagModuleAccount, false, "add a module account for the module named by the first argument")
+	cmd.Flags().String(flagModulePermissions, "", "comma separated permissions of the module account (minter|burner|staking)")
+
+	return cmd
+}
+
+func lookupKeyAddress(cmd *cobra.Command, name string) (sdk.AccAddress, error) {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := keys.NewKeyring(sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend), viper.GetString(flagClientHome), inBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from keyring: %w", err)
+	}
+
+	return info.GetAddress(), nil
+}
+
+// newGenesisAccount creates a base account, or a vesting account if a vesting
+// amount has been given.
+func newGenesisAccount(addr sdk.AccAddress, coins sdk.Coins) (authexported.GenesisAccount, error) {
+	vestingAmt, err := sdk.ParseCoins(viper.GetString(flagVestingAmt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+
+	baseAccount := auth.NewBaseAccount(addr, nil, 0, 0)
+	if vestingAmt.IsZero() {
+		return baseAccount, nil
+	}
+
+	if !vestingAmt.IsAllLTE(coins) {
+		return nil, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	vestingStart := viper.GetInt64(flagVestingStart)
+	vestingEnd := viper.GetInt64(flagVestingEnd)
+	baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+
+	switch {
+	case vestingStart != 0 && vestingEnd != 0:
+		return authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart), nil
+
+	case vestingEnd != 0:
+		return authvesting.NewDelayedVestingAccountRaw(baseVestingAccount), nil
+
+	default:
+		return nil, errors.New("invalid vesting parameters; must supply start and end time or end time")
+	}
+}
+
+// newModuleGenesisAccount creates the module account of the named module.
+func newModuleGenesisAccount(name, permissions string) (authexported.GenesisAccount, error) {
+	if viper.GetString(flagVestingAmt) != "" {
+		return nil, errors.New("module accounts cannot be vesting accounts")
+	}
+
+	var perms []string
+	if permissions != "" {
+		perms = strings.Split(permissions, ",")
+	}
+
+	for _, perm := range perms {
+		switch perm {
+		case supply.Minter, supply.Burner, supply.Staking:
+		default:
+			return nil, fmt.Errorf("invalid module account permission %s", perm)
+		}
+	}
+
+	moduleAccount := &supply.ModuleAccount{
+		BaseAccount: auth.NewBaseAccount(supply.NewModuleAddress(name), nil, 0, 0),
+		Name:        name,
+		Permissions: perms,
+	}
+
+	return moduleAccount, nil
+}
+
+// addGenesisAccount adds the account and coins to the genesis state of the
+// auth and bank modules. If an account already exists at the address, the coins
+// are added to its balance instead. A total supply which has been set in the
+// genesis state of the supply module is increased by the coins and has to match
+// the sum of the balances.
+func addGenesisAccount(
+	cdc *codec.Codec, appState map[string]json.RawMessage, genAccount authexported.GenesisAccount, coins sdk.Coins,
+) error {
+
+	if err := genAccount.Validate(); err != nil {
+		return fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	addr := genAccount.GetAddress()
+	authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
+	if !authGenState.Accounts.Contains(addr) {
+		// add the new account to the set of genesis accounts and sanitize the
+		// accounts afterwards
+		authGenState.Accounts = append(authGenState.Accounts, genAccount)
+		authGenState.Accounts = auth.SanitizeGenesisAccounts(authGenState.Accounts)
+	} else if _, ok := genAccount.(*auth.BaseAccount); !ok {
+		return fmt.Errorf("cannot change the type of the existing account at address %s", addr)
+	}
+
+	bankGenState := bank.GetGenesisStateFromAppState(cdc, appState)
+	merged := false
+	for i, balance := range bankGenState.Balances {
+		if balance.Address.Equals(addr) {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+			merged = true
+			break
+		}
+	}
+	if !merged {
+		bankGenState.Balances = append(bankGenState.Balances, bank.Balance{Address: addr, Coins: coins.Sort()})
+	}
+	bankGenState.Balances = bank.SanitizeGenesisBalances(bankGenState.Balances)
+
+	var supplyGenState supply.GenesisState
+	if appState[supply.ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[supply.ModuleName], &supplyGenState)
+	}
+
+	// an empty supply is computed from the balances on InitGenesis
+	if !supplyGenState.Supply.Empty() {
+		supplyGenState.Supply = supplyGenState.Supply.Add(coins...)
+		if err := validateSupply(bankGenState.Balances, supplyGenState.Supply); err != nil {
+			return err
+		}
+	}
+
+	authGenStateBz, err := cdc.MarshalJSON(authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	supplyGenStateBz, err := cdc.MarshalJSON(supplyGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal supply genesis state: %w", err)
+	}
+
+	appState[auth.ModuleName] = authGenStateBz
+	appState[bank.ModuleName] = bankGenStateBz
+	appState[supply.ModuleName] = supplyGenStateBz
+
+	return nil
+}
+
+// validateSupply returns an error if the total supply does not match the sum of
+// the balances.
+func validateSupply(balances []bank.Balance, totalSupply sdk.Coins) error {
+	var sum sdk.Coins
+	for _, balance := range balances {
+		sum = sum.Add(balance.Coins...)
+	}
+
+	if !sum.IsAllGTE(totalSupply) || !totalSupply.IsAllGTE(sum) {
+		return fmt.Errorf("total supply %s does not match the sum of the genesis balances %s", totalSupply, sum)
+	}
+
+	return nil
+}
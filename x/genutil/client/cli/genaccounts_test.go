@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func makeGenAccountsCodec() *codec.Codec {
+	cdc := makeCodec()
+	auth.RegisterCodec(cdc)
+	authvesting.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	return cdc
+}
+
+func TestAddGenesisAccount(t *testing.T) {
+	cdc := makeGenAccountsCodec()
+	appState := map[string]json.RawMessage{}
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	require.NoError(t, addGenesisAccount(cdc, appState, auth.NewBaseAccount(addr, nil, 0, 0), coins))
+
+	// adding an existing account merges the balances
+	require.NoError(t, addGenesisAccount(cdc, appState, auth.NewBaseAccount(addr, nil, 0, 0), coins))
+
+	authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, authGenState.Accounts, 1)
+	bankGenState := bank.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, []bank.Balance{{Address: addr, Coins: coins.Add(coins...)}}, bankGenState.Balances)
+
+	// the type of an existing account cannot be changed
+	vestingAcc := authvesting.NewDelayedVestingAccount(auth.NewBaseAccount(addr, nil, 0, 0), coins, 1000)
+	require.Error(t, addGenesisAccount(cdc, appState, vestingAcc, coins))
+}
+
+func TestAddGenesisAccountSupply(t *testing.T) {
+	cdc := makeGenAccountsCodec()
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	appState := map[string]json.RawMessage{
+		supply.ModuleName: cdc.MustMarshalJSON(supply.NewGenesisState(coins)),
+		bank.ModuleName:   cdc.MustMarshalJSON(bank.NewGenesisState(true, []bank.Balance{{Address: addr1, Coins: coins}})),
+	}
+
+	// the total supply is increased by the added coins
+	require.NoError(t, addGenesisAccount(cdc, appState, auth.NewBaseAccount(addr2, nil, 0, 0), coins))
+
+	var supplyGenState supply.GenesisState
+	cdc.MustUnmarshalJSON(appState[supply.ModuleName], &supplyGenState)
+	require.Equal(t, coins.Add(coins...), supplyGenState.Supply)
+
+	// an inconsistent total supply is rejected
+	appState[supply.ModuleName] = cdc.MustMarshalJSON(supply.NewGenesisState(coins))
+	require.Error(t, addGenesisAccount(cdc, appState, auth.NewBaseAccount(addr2, nil, 0, 0), coins))
+}
+
+func TestNewGenesisAccount(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	genAccount, err := newGenesisAccount(addr, coins)
+	require.NoError(t, err)
+	require.IsType(t, &auth.BaseAccount{}, genAccount)
+
+	viper.Set(flagVestingAmt, "50stake")
+	_, err = newGenesisAccount(addr, coins)
+	require.Error(t, err)
+
+	viper.Set(flagVestingEnd, 2000)
+	genAccount, err = newGenesisAccount(addr, coins)
+	require.NoError(t, err)
+	require.IsType(t, &authvesting.DelayedVestingAccount{}, genAccount)
+
+	viper.Set(flagVestingStart, 1000)
+	genAccount, err = newGenesisAccount(addr, coins)
+	require.NoError(t, err)
+	require.IsType(t, &authvesting.ContinuousVestingAccount{}, genAccount)
+
+	viper.Set(flagVestingAmt, "150stake")
+	_, err = newGenesisAccount(addr, coins)
+	require.Error(t, err)
+
+	_, err = newModuleGenesisAccount("mint", "minter")
+	require.Error(t, err)
+
+	viper.Set(flagVestingAmt, "")
+	genAccount, err = newModuleGenesisAccount("mint", "minter")
+	require.NoError(t, err)
+	require.Equal(t, supply.NewModuleAddress("mint"), genAccount.GetAddress())
+	require.NoError(t, genAccount.Validate())
+
+	_, err = newModuleGenesisAccount("mint", "printer")
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -24,8 +25,9 @@ import (
 )
 
 const (
-	flagOverwrite  = "overwrite"
-	flagClientHome = "home-client"
+	flagOverwrite      = "overwrite"
+	flagClientHome     = "home-client"
+	flagIndexAllEvents = "index-all-events"
 )
 
 type printInfo struct {
@@ -83,6 +85,13 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 
 			config.Moniker = args[0]
 
+			// only index the event attributes declared by the modules unless
+			// all of them are requested to be indexed
+			if !viper.GetBool(flagIndexAllEvents) {
+				config.TxIndex.IndexAllKeys = false
+				config.TxIndex.IndexKeys = strings.Join(mbm.IndexedEvents(), ",")
+			}
+
 			genFile := config.GenesisFile()
 			if !viper.GetBool(flagOverwrite) && tmos.FileExists(genFile) {
 				return fmt.Errorf("genesis.json file already exists: %v", genFile)
@@ -121,6 +130,7 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
 	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file")
 	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().Bool(flagIndexAllEvents, false, "index all event attributes instead of only those declared by the modules")
 
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -41,6 +42,10 @@ func TestInitCmd(t *testing.T) {
 	cmd := InitCmd(ctx, cdc, testMbm, home)
 
 	require.NoError(t, cmd.RunE(nil, []string{"appnode-test"}))
+
+	// only the event attributes declared by the modules are indexed
+	require.False(t, ctx.Config.TxIndex.IndexAllKeys)
+	require.Equal(t, strings.Join(testMbm.IndexedEvents(), ","), ctx.Config.TxIndex.IndexKeys)
 }
 
 func setupClientHome(t *testing.T) func() {
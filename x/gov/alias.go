@@ -128,6 +128,7 @@ type (
 	Keeper               = keeper.Keeper
 	Content              = types.Content
 	Handler              = types.Handler
+	ProposalWarner       = types.ProposalWarner
 	Deposit              = types.Deposit
 	Deposits             = types.Deposits
 	GenesisState         = types.GenesisState
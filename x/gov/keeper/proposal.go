@@ -24,6 +24,13 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 	}
 
+	// Inspect the resulting state to record the concrete impact of the proposal
+	// for voters.
+	var warnings []string
+	if warner, ok := keeper.router.GetWarner(content.ProposalRoute()); ok {
+		warnings = warner(cacheCtx, content)
+	}
+
 	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
 		return types.Proposal{}, err
@@ -33,6 +40,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.Warnings = warnings
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -123,6 +123,33 @@ func TestSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestSubmitProposalWarnings(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	dryRunKey := []byte("dryrun")
+	keeper.router = types.NewRouter().
+		AddRoute(types.RouterKey, func(ctx sdk.Context, content types.Content) error {
+			ctx.KVStore(keeper.storeKey).Set(dryRunKey, []byte(content.GetTitle()))
+			return nil
+		}).
+		AddWarner(types.RouterKey, func(ctx sdk.Context, content types.Content) []string {
+			// the warner inspects the state the handler left behind
+			return []string{"applied " + string(ctx.KVStore(keeper.storeKey).Get(dryRunKey))}
+		})
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	require.Equal(t, []string{"applied Test"}, proposal.Warnings)
+	require.Contains(t, proposal.String(), "applied Test")
+
+	// the dry-run is not persisted, the warnings are
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(dryRunKey))
+
+	gotProposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, proposal.Warnings, gotProposal.Warnings)
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
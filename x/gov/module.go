@@ -16,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov/client"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/rest"
@@ -27,6 +29,7 @@ import (
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.HasIndexedEvents    = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 )
 
@@ -94,6 +97,15 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(StoreKey, cdc)
 }
 
+// IndexedEvents returns the event attributes of the gov module to index.
+func (AppModuleBasic) IndexedEvents() []string {
+	return []string{
+		fmt.Sprintf("%s.%s", types.EventTypeSubmitProposal, types.AttributeKeyProposalID),
+		fmt.Sprintf("%s.%s", types.EventTypeProposalDeposit, types.AttributeKeyProposalID),
+		fmt.Sprintf("%s.%s", types.EventTypeProposalVote, types.AttributeKeyProposalID),
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the gov module.
@@ -156,6 +168,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns the auth and bank modules, whose accounts and
+// balances store the deposits of the gov module account.
+func (AppModule) GenesisDependencies() []string {
+	return []string{authtypes.ModuleName, bank.ModuleName}
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the gov
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
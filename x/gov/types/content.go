@@ -30,6 +30,12 @@ type Content interface {
 // governance process.
 type Handler func(ctx sdk.Context, content Content) error
 
+// ProposalWarner defines a function that inspects the state after a proposal's
+// content has been applied to a cache-wrapped context upon submission, and
+// returns human readable warnings about its impact, e.g. validators that would
+// be unbonded. Warnings do not prevent the proposal from being submitted.
+type ProposalWarner func(ctx sdk.Context, content Content) []string
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(c Content) error {
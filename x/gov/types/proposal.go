@@ -28,6 +28,8 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	Warnings []string `json:"warnings,omitempty" yaml:"warnings,omitempty"` // Warnings about the impact of the proposal, determined by a dry-run upon submission
 }
 
 // NewProposal creates a new Proposal instance
@@ -58,7 +60,19 @@ func (p Proposal) String() string {
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
 		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
-	)
+	) + p.warningsString()
+}
+
+func (p Proposal) warningsString() string {
+	if len(p.Warnings) == 0 {
+		return ""
+	}
+
+	out := "\n  Warnings:"
+	for _, warning := range p.Warnings {
+		out += fmt.Sprintf("\n    - %s", warning)
+	}
+	return out
 }
 
 // Proposals is an array of proposal
@@ -15,18 +15,22 @@ type Router interface {
 	AddRoute(r string, h Handler) (rtr Router)
 	HasRoute(r string) bool
 	GetRoute(path string) (h Handler)
+	AddWarner(r string, w ProposalWarner) (rtr Router)
+	GetWarner(path string) (w ProposalWarner, ok bool)
 	Seal()
 }
 
 type router struct {
-	routes map[string]Handler
-	sealed bool
+	routes  map[string]Handler
+	warners map[string]ProposalWarner
+	sealed  bool
 }
 
 // NewRouter creates a new Router interface instance
 func NewRouter() Router {
 	return &router{
-		routes: make(map[string]Handler),
+		routes:  make(map[string]Handler),
+		warners: make(map[string]ProposalWarner),
 	}
 }
 
@@ -70,3 +74,28 @@ func (rtr *router) GetRoute(path string) Handler {
 
 	return rtr.routes[path]
 }
+
+// AddWarner adds a proposal warner for a given path, which must have a route
+// handler registered. It returns the Router so AddWarner calls can be linked. It
+// will panic if the router is sealed.
+func (rtr *router) AddWarner(path string, w ProposalWarner) Router {
+	if rtr.sealed {
+		panic("router sealed; cannot add proposal warner")
+	}
+
+	if !rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route \"%s\" does not exist", path))
+	}
+	if rtr.warners[path] != nil {
+		panic(fmt.Sprintf("warner for route %s has already been initialized", path))
+	}
+
+	rtr.warners[path] = w
+	return rtr
+}
+
+// GetWarner returns the proposal warner for a given path, if any.
+func (rtr *router) GetWarner(path string) (ProposalWarner, bool) {
+	w, ok := rtr.warners[path]
+	return w, ok
+}
@@ -118,6 +118,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns no modules, since the genesis initialization of
+// the mint module only sets its minter and params.
+func (AppModule) GenesisDependencies() []string { return nil }
+
 // ExportGenesis returns the exported genesis state as raw bytes for the mint
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
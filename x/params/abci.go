@@ -0,0 +1,36 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// EndBlocker reverts the parameter changes of the trials whose trial period
+// has ended without being confirmed. If the previous values cannot be
+// restored, e.g. because the validation of a parameter has changed or a
+// parameter has been changed again in the meantime, the trial is dropped with
+// its changes kept and the error is logged.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	logger := k.Logger(ctx)
+
+	for _, trial := range k.GetDueTrials(ctx, ctx.BlockHeight()) {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyTrialID, fmt.Sprintf("%d", trial.ID)),
+		}
+
+		if err := k.RevertTrial(cacheCtx, trial); err != nil {
+			k.DeleteTrial(ctx, trial)
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRevertError, err.Error()))
+			logger.Error(fmt.Sprintf("failed to revert parameter changes of trial %d: %s", trial.ID, err))
+		} else {
+			writeCache()
+			logger.Info(fmt.Sprintf("reverted parameter changes of trial %d", trial.ID))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRevertTrial, attrs...))
+	}
+}
@@ -13,6 +13,19 @@ const (
 	ModuleName         = types.ModuleName
 	RouterKey          = types.RouterKey
 	ProposalTypeChange = types.ProposalTypeChange
+
+	QuerierRoute             = types.QuerierRoute
+	ProposalTypeTrialChange  = types.ProposalTypeTrialChange
+	ProposalTypeConfirmTrial = types.ProposalTypeConfirmTrial
+	QueryTrials              = types.QueryTrials
+	QueryTrial               = types.QueryTrial
+	EventTypeStartTrial      = types.EventTypeStartTrial
+	EventTypeConfirmTrial    = types.EventTypeConfirmTrial
+	EventTypeRevertTrial     = types.EventTypeRevertTrial
+	AttributeKeyTrialID      = types.AttributeKeyTrialID
+	AttributeKeyRevertHeight = types.AttributeKeyRevertHeight
+	AttributeKeyRevertError  = types.AttributeKeyRevertError
+	AttributeValueCategory   = types.AttributeValueCategory
 )
 
 var (
@@ -31,6 +44,26 @@ var (
 	NewParamChange             = types.NewParamChange
 	ValidateChanges            = types.ValidateChanges
 
+	ErrInvalidTrialPeriod           = types.ErrInvalidTrialPeriod
+	ErrUnknownTrial                 = types.ErrUnknownTrial
+	ErrParameterOnTrial             = types.ErrParameterOnTrial
+	ErrParameterChanged             = types.ErrParameterChanged
+	NewTrialParameterChangeProposal = types.NewTrialParameterChangeProposal
+	NewConfirmTrialProposal         = types.NewConfirmTrialProposal
+	NewTrial                        = types.NewTrial
+	NewGenesisState                 = types.NewGenesisState
+	DefaultGenesisState             = types.DefaultGenesisState
+	ValidateGenesis                 = types.ValidateGenesis
+	NewQueryTrialParams             = types.NewQueryTrialParams
+	GetTrialKey                     = types.GetTrialKey
+	GetTrialQueueKey                = types.GetTrialQueueKey
+	GetTrialQueueHeightKey          = types.GetTrialQueueHeightKey
+
+	// variable aliases
+	TrialKeyPrefix      = types.TrialKeyPrefix
+	TrialQueueKeyPrefix = types.TrialQueueKeyPrefix
+	NextTrialIDKey      = types.NextTrialIDKey
+
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 )
@@ -44,4 +77,10 @@ type (
 	KeyTable                = subspace.KeyTable
 	ParameterChangeProposal = types.ParameterChangeProposal
 	ParamChange             = types.ParamChange
+
+	TrialParameterChangeProposal = types.TrialParameterChangeProposal
+	ConfirmTrialProposal         = types.ConfirmTrialProposal
+	Trial                        = types.Trial
+	GenesisState                 = types.GenesisState
+	QueryTrialParams             = types.QueryTrialParams
 )
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetQueryCmd returns the query commands for the params module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the params module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryTrials(cdc),
+		GetCmdQueryTrial(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryTrials returns the command to query the trials of parameter
+// changes which have neither been reverted nor confirmed.
+func GetCmdQueryTrials(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trials",
+		Short: "Query the pending trials of parameter changes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTrials)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var trials []types.Trial
+			if err := cdc.UnmarshalJSON(res, &trials); err != nil {
+				return fmt.Errorf("failed to unmarshal trials: %w", err)
+			}
+
+			return cliCtx.PrintOutput(trials)
+		},
+	}
+}
+
+// GetCmdQueryTrial returns the command to query a trial of parameter changes.
+func GetCmdQueryTrial(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trial [trial-id]",
+		Short: "Query a pending trial of parameter changes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			trialID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("trial-id %s not a valid uint, please input a valid trial-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTrialParams(trialID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTrial)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var trial types.Trial
+			if err := cdc.UnmarshalJSON(res, &trial); err != nil {
+				return fmt.Errorf("failed to unmarshal trial: %w", err)
+			}
+
+			return cliCtx.PrintOutput(trial)
+		},
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
@@ -88,3 +90,140 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitTrialProposal implements a command handler for submitting a trial
+// parameter change proposal transaction.
+func GetCmdSubmitTrialProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trial-param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a parameter change proposal for a trial period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a trial parameter change proposal along with an initial deposit.
+The parameter changes are applied for a trial period of a number of blocks and are
+reverted at the end of the trial period, unless a confirm-trial proposal confirming
+the trial passes before. The id of the trial is emitted when the proposal passes.
+
+The proposal details must be supplied via a JSON file, with the same format as for
+a param-change proposal and a trial period.
+
+Example:
+$ %s tx gov submit-proposal trial-param-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Staking Param Change",
+  "description": "Update max validators for a week",
+  "changes": [
+    {
+      "subspace": "staking",
+      "key": "MaxValidators",
+      "value": 105
+    }
+  ],
+  "trial_period": 100800,
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := paramscutils.ParseTrialParamChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewTrialParameterChangeProposal(
+				proposal.Title, proposal.Description, proposal.Changes.ToParamChanges(), proposal.TrialPeriod,
+			)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitConfirmTrialProposal implements a command handler for submitting
+// a proposal confirming the parameter changes of a trial.
+func GetCmdSubmitConfirmTrialProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-trial [trial-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal confirming the parameter changes of a trial",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal confirming the parameter changes of a trial along with an
+initial deposit. The changes of a confirmed trial are not reverted at the end of the
+trial period. The proposal has to pass before the end of the trial period.
+
+Example:
+$ %s tx gov submit-proposal confirm-trial 1 --title="Confirm max validators" --description="..." --deposit="10000stake" --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			trialID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("trial-id %s not a valid uint, please input a valid trial-id", args[0])
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewConfirmTrialProposal(title, description, trialID)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -6,5 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/client/rest"
 )
 
-// param change proposal handler
-var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+var (
+	// param change proposal handler
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+	// trial param change and confirm trial proposal handlers
+	TrialProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitTrialProposal, rest.TrialProposalRESTHandler)
+	ConfirmTrialProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitConfirmTrialProposal, rest.ConfirmTrialProposalRESTHandler,
+	)
+)
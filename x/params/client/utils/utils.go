@@ -7,7 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
-	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
@@ -42,6 +42,40 @@ type (
 		Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
 	}
+
+	// TrialParamChangeProposalJSON defines a TrialParameterChangeProposal with
+	// a deposit used to parse trial parameter change proposals from a JSON file.
+	TrialParamChangeProposalJSON struct {
+		Title       string           `json:"title" yaml:"title"`
+		Description string           `json:"description" yaml:"description"`
+		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
+		TrialPeriod int64            `json:"trial_period" yaml:"trial_period"`
+		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+	}
+
+	// TrialParamChangeProposalReq defines a trial parameter change proposal
+	// request body.
+	TrialParamChangeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string           `json:"title" yaml:"title"`
+		Description string           `json:"description" yaml:"description"`
+		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
+		TrialPeriod int64            `json:"trial_period" yaml:"trial_period"`
+		Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+	}
+
+	// ConfirmTrialProposalReq defines a confirm trial proposal request body.
+	ConfirmTrialProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		TrialID     uint64         `json:"trial_id" yaml:"trial_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
 
 func NewParamChangeJSON(subspace, key string, value json.RawMessage) ParamChangeJSON {
@@ -49,14 +83,14 @@ func NewParamChangeJSON(subspace, key string, value json.RawMessage) ParamChange
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
-func (pcj ParamChangeJSON) ToParamChange() params.ParamChange {
-	return params.NewParamChange(pcj.Subspace, pcj.Key, string(pcj.Value))
+func (pcj ParamChangeJSON) ToParamChange() types.ParamChange {
+	return types.NewParamChange(pcj.Subspace, pcj.Key, string(pcj.Value))
 }
 
 // ToParamChanges converts a slice of ParamChangeJSON objects to a slice of
 // ParamChange.
-func (pcj ParamChangesJSON) ToParamChanges() []params.ParamChange {
-	res := make([]params.ParamChange, len(pcj))
+func (pcj ParamChangesJSON) ToParamChanges() []types.ParamChange {
+	res := make([]types.ParamChange, len(pcj))
 	for i, pc := range pcj {
 		res[i] = pc.ToParamChange()
 	}
@@ -79,3 +113,20 @@ func ParseParamChangeProposalJSON(cdc *codec.Codec, proposalFile string) (ParamC
 
 	return proposal, nil
 }
+
+// ParseTrialParamChangeProposalJSON reads and parses a
+// TrialParamChangeProposalJSON from file.
+func ParseTrialParamChangeProposalJSON(cdc *codec.Codec, proposalFile string) (TrialParamChangeProposalJSON, error) {
+	proposal := TrialParamChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
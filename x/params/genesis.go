@@ -0,0 +1,26 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// InitGenesis sets the trials which have neither been reverted nor confirmed.
+func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	for _, trial := range data.Trials {
+		k.SetTrial(ctx, trial)
+	}
+
+	k.SetNextTrialID(ctx, data.NextTrialID)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	trials := []types.Trial{}
+	k.IterateTrials(ctx, func(trial types.Trial) bool {
+		trials = append(trials, trial)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetNextTrialID(ctx), trials)
+}
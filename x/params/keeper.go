@@ -11,21 +11,28 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+// ParamChangeWarner defines a function that inspects the state after the given
+// changes to a subspace have been applied and returns warnings about their
+// impact.
+type ParamChangeWarner func(ctx sdk.Context, changes []ParamChange) []string
+
 // Keeper of the global paramstore
 type Keeper struct {
-	cdc    *codec.Codec
-	key    sdk.StoreKey
-	tkey   sdk.StoreKey
-	spaces map[string]*Subspace
+	cdc     *codec.Codec
+	key     sdk.StoreKey
+	tkey    sdk.StoreKey
+	spaces  map[string]*Subspace
+	warners map[string]ParamChangeWarner
 }
 
 // NewKeeper constructs a params keeper
 func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey) Keeper {
 	return Keeper{
-		cdc:    cdc,
-		key:    key,
-		tkey:   tkey,
-		spaces: make(map[string]*Subspace),
+		cdc:     cdc,
+		key:     key,
+		tkey:    tkey,
+		spaces:  make(map[string]*Subspace),
+		warners: make(map[string]ParamChangeWarner),
 	}
 }
 
@@ -59,3 +66,17 @@ func (k Keeper) GetSubspace(s string) (Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// AddParamChangeWarner registers the warner of an allocated subspace, which is
+// called by the parameter change proposal warner.
+func (k Keeper) AddParamChangeWarner(s string, w ParamChangeWarner) {
+	if _, ok := k.spaces[s]; !ok {
+		panic(fmt.Sprintf("subspace %s has not been allocated", s))
+	}
+
+	if _, ok := k.warners[s]; ok {
+		panic(fmt.Sprintf("param change warner for subspace %s already registered", s))
+	}
+
+	k.warners[s] = w
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // NewParamChangeProposalHandler creates a new governance Handler for a ParamChangeProposal
@@ -15,12 +16,59 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 		case ParameterChangeProposal:
 			return handleParameterChangeProposal(ctx, k, c)
 
+		case TrialParameterChangeProposal:
+			return handleTrialParameterChangeProposal(ctx, k, c)
+
+		case ConfirmTrialProposal:
+			return handleConfirmTrialProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
 		}
 	}
 }
 
+// NewParamChangeProposalWarner creates a new governance ProposalWarner for a
+// ParamChangeProposal or TrialParameterChangeProposal. It calls the registered
+// warner of every subspace changed by the proposal, in the order the subspaces
+// first appear in the changes.
+func NewParamChangeProposalWarner(k Keeper) govtypes.ProposalWarner {
+	return func(ctx sdk.Context, content govtypes.Content) []string {
+		var proposalChanges []ParamChange
+		switch p := content.(type) {
+		case ParameterChangeProposal:
+			proposalChanges = p.Changes
+
+		case TrialParameterChangeProposal:
+			proposalChanges = p.Changes
+
+		default:
+			return nil
+		}
+
+		var (
+			subspaces []string
+			changes   = make(map[string][]ParamChange)
+		)
+
+		for _, c := range proposalChanges {
+			if _, ok := changes[c.Subspace]; !ok {
+				subspaces = append(subspaces, c.Subspace)
+			}
+			changes[c.Subspace] = append(changes[c.Subspace], c)
+		}
+
+		var warnings []string
+		for _, s := range subspaces {
+			if w, ok := k.warners[s]; ok {
+				warnings = append(warnings, w(ctx, changes[s])...)
+			}
+		}
+
+		return warnings
+	}
+}
+
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeProposal) error {
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
@@ -39,3 +87,41 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeP
 
 	return nil
 }
+
+func handleTrialParameterChangeProposal(ctx sdk.Context, k Keeper, p TrialParameterChangeProposal) error {
+	trial, err := k.StartTrial(ctx, p.Changes, p.TrialPeriod)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		fmt.Sprintf("started trial %d of parameter changes; revert height: %d", trial.ID, trial.RevertHeight),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStartTrial,
+			sdk.NewAttribute(types.AttributeKeyTrialID, fmt.Sprintf("%d", trial.ID)),
+			sdk.NewAttribute(types.AttributeKeyRevertHeight, fmt.Sprintf("%d", trial.RevertHeight)),
+		),
+	)
+
+	return nil
+}
+
+func handleConfirmTrialProposal(ctx sdk.Context, k Keeper, p ConfirmTrialProposal) error {
+	if err := k.ConfirmTrial(ctx, p.TrialID); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("confirmed trial %d of parameter changes", p.TrialID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConfirmTrial,
+			sdk.NewAttribute(types.AttributeKeyTrialID, fmt.Sprintf("%d", p.TrialID)),
+		),
+	)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -129,3 +130,34 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalWarner(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	input.keeper.Subspace("OtherSubspace")
+
+	input.keeper.AddParamChangeWarner(testSubspace, func(ctx sdk.Context, changes []params.ParamChange) []string {
+		var param uint16
+		ss.Get(ctx, []byte(keyMaxValidators), &param)
+		return []string{fmt.Sprintf("%d changes, max validators %d", len(changes), param)}
+	})
+	require.Panics(t, func() {
+		input.keeper.AddParamChangeWarner(testSubspace, func(sdk.Context, []params.ParamChange) []string { return nil })
+	})
+	require.Panics(t, func() {
+		input.keeper.AddParamChangeWarner("UnknownSubspace", func(sdk.Context, []params.ParamChange) []string { return nil })
+	})
+
+	tp := testProposal(
+		params.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		params.NewParamChange("OtherSubspace", keyMaxValidators, "2"),
+		params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, params.NewParameterChangeProposal("Test", "description", tp.Changes[:1])))
+
+	warner := params.NewParamChangeProposalWarner(input.keeper)
+	require.Equal(t, []string{"2 changes, max validators 1"}, warner(input.ctx, tp))
+}
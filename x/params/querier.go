@@ -0,0 +1,62 @@
+package params
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// NewQuerier creates a querier for the trials of the params module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryTrials:
+			return queryTrials(ctx, k)
+
+		case types.QueryTrial:
+			return queryTrial(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryTrials(ctx sdk.Context, k Keeper) ([]byte, error) {
+	trials := []types.Trial{}
+	k.IterateTrials(ctx, func(trial types.Trial) bool {
+		trials = append(trials, trial)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(k.cdc, trials)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTrial(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTrialParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	trial, found := k.GetTrial(ctx, params.TrialID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownTrial, "%d", params.TrialID)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, trial)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,206 @@
+package params
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetNextTrialID returns the id of the next trial.
+func (k Keeper) GetNextTrialID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextTrialIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextTrialID sets the id of the next trial.
+func (k Keeper) SetNextTrialID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextTrialIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTrial returns a trial which has neither been reverted nor confirmed.
+func (k Keeper) GetTrial(ctx sdk.Context, id uint64) (trial types.Trial, found bool) {
+	bz := ctx.KVStore(k.key).Get(types.GetTrialKey(id))
+	if bz == nil {
+		return trial, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &trial)
+	return trial, true
+}
+
+// SetTrial sets a trial and schedules its revert.
+func (k Keeper) SetTrial(ctx sdk.Context, trial types.Trial) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.GetTrialKey(trial.ID), k.cdc.MustMarshalBinaryBare(trial))
+	store.Set(types.GetTrialQueueKey(trial.RevertHeight, trial.ID), sdk.Uint64ToBigEndian(trial.ID))
+}
+
+// DeleteTrial removes a trial and its scheduled revert.
+func (k Keeper) DeleteTrial(ctx sdk.Context, trial types.Trial) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetTrialKey(trial.ID))
+	store.Delete(types.GetTrialQueueKey(trial.RevertHeight, trial.ID))
+}
+
+// IterateTrials iterates over all trials in the order of their ids. If true is
+// returned from the callback, iteration is halted.
+func (k Keeper) IterateTrials(ctx sdk.Context, cb func(types.Trial) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.TrialKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trial types.Trial
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trial)
+
+		if cb(trial) {
+			break
+		}
+	}
+}
+
+// GetDueTrials returns the trials whose revert height is at most the given
+// height, ordered by revert height and id.
+func (k Keeper) GetDueTrials(ctx sdk.Context, height int64) (trials []types.Trial) {
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator(types.TrialQueueKeyPrefix, sdk.PrefixEndBytes(types.GetTrialQueueHeightKey(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		trial, found := k.GetTrial(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("trial %X is scheduled but does not exist", iterator.Value()))
+		}
+
+		trials = append(trials, trial)
+	}
+
+	return trials
+}
+
+// getTrialsByParameter returns the ids of the trials by the subspace and key
+// of the parameters they change.
+func (k Keeper) getTrialsByParameter(ctx sdk.Context) map[string]uint64 {
+	trials := make(map[string]uint64)
+	k.IterateTrials(ctx, func(trial types.Trial) bool {
+		for _, c := range trial.Changes {
+			trials[trialParameterKey(c)] = trial.ID
+		}
+		return false
+	})
+
+	return trials
+}
+
+func trialParameterKey(c ParamChange) string {
+	return c.Subspace + "/" + c.Key
+}
+
+// StartTrial applies the parameter changes and records the previous values of
+// the parameters, which are restored at the end of the trial period unless the
+// trial is confirmed. Only parameters which are already set and which are not
+// changed by another trial can be changed on trial.
+func (k Keeper) StartTrial(ctx sdk.Context, changes []ParamChange, trialPeriod int64) (types.Trial, error) {
+	if trialPeriod <= 0 {
+		return types.Trial{}, types.ErrInvalidTrialPeriod
+	}
+
+	trials := k.getTrialsByParameter(ctx)
+
+	applied := make([]ParamChange, len(changes))
+	reverts := make([]ParamChange, len(changes))
+	for i, c := range changes {
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return types.Trial{}, sdkerrors.Wrap(ErrUnknownSubspace, c.Subspace)
+		}
+
+		if id, ok := trials[trialParameterKey(c)]; ok {
+			return types.Trial{}, sdkerrors.Wrapf(types.ErrParameterOnTrial, "key: %s, trial: %d", c.Key, id)
+		}
+
+		prev := ss.GetRaw(ctx, []byte(c.Key))
+		if prev == nil {
+			return types.Trial{}, sdkerrors.Wrapf(ErrSettingParameter, "key: %s, err: cannot revert unset parameter", c.Key)
+		}
+
+		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return types.Trial{}, sdkerrors.Wrapf(ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		}
+
+		applied[i] = NewParamChange(c.Subspace, c.Key, string(ss.GetRaw(ctx, []byte(c.Key))))
+		reverts[i] = NewParamChange(c.Subspace, c.Key, string(prev))
+	}
+
+	id := k.GetNextTrialID(ctx)
+	trial := types.NewTrial(id, ctx.BlockHeight()+trialPeriod, applied, reverts)
+	k.SetTrial(ctx, trial)
+	k.SetNextTrialID(ctx, id+1)
+
+	return trial, nil
+}
+
+// ConfirmTrial confirms the parameter changes of a trial, so that they are not
+// reverted.
+func (k Keeper) ConfirmTrial(ctx sdk.Context, id uint64) error {
+	trial, found := k.GetTrial(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownTrial, "%d", id)
+	}
+
+	k.DeleteTrial(ctx, trial)
+	return nil
+}
+
+// RevertTrial restores the values of the parameters changed by a trial and
+// removes the trial. The values are restored in the reverse order of the
+// changes, so that a parameter changed multiple times gets its value before the
+// trial. If any of the parameters has been changed since the trial started,
+// e.g. by a parameter change proposal, none of them is restored and
+// ErrParameterChanged is returned.
+func (k Keeper) RevertTrial(ctx sdk.Context, trial types.Trial) error {
+	k.DeleteTrial(ctx, trial)
+
+	// the last change of a parameter holds its value at the end of the trial
+	values := make(map[string]ParamChange)
+	for _, c := range trial.Changes {
+		values[trialParameterKey(c)] = c
+	}
+
+	for _, c := range trial.Changes {
+		if values[trialParameterKey(c)] != c {
+			continue
+		}
+
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(ErrUnknownSubspace, c.Subspace)
+		}
+
+		if !bytes.Equal(ss.GetRaw(ctx, []byte(c.Key)), []byte(c.Value)) {
+			return sdkerrors.Wrapf(types.ErrParameterChanged, "key: %s", c.Key)
+		}
+	}
+
+	for i := len(trial.Reverts) - 1; i >= 0; i-- {
+		c := trial.Reverts[i]
+
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(ErrUnknownSubspace, c.Subspace)
+		}
+
+		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return sdkerrors.Wrapf(ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package params_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+func testTrialProposal(trialPeriod int64, changes ...params.ParamChange) params.TrialParameterChangeProposal {
+	return params.NewTrialParameterChangeProposal("Test", "description", changes, trialPeriod)
+}
+
+func newTrialTestSubspace(input testInput) subspace.Subspace {
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	ss.Set(input.ctx, []byte(keyMaxValidators), uint16(100))
+
+	return ss
+}
+
+func TestTrialReverted(t *testing.T) {
+	input := newTestInput(t)
+	ss := newTrialTestSubspace(input)
+	ctx := input.ctx.WithBlockHeight(10)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	tp := testTrialProposal(5,
+		params.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		params.NewParamChange(testSubspace, keyMaxValidators, "2"),
+	)
+	require.NoError(t, hdlr(ctx, tp))
+
+	var param uint16
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(2), param)
+
+	trial, found := input.keeper.GetTrial(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, int64(15), trial.RevertHeight)
+
+	params.EndBlocker(ctx.WithBlockHeight(14), input.keeper)
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(2), param)
+
+	params.EndBlocker(ctx.WithBlockHeight(15), input.keeper)
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(100), param)
+
+	_, found = input.keeper.GetTrial(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, input.keeper.GetDueTrials(ctx, 100))
+}
+
+func TestTrialConfirmed(t *testing.T) {
+	input := newTestInput(t)
+	ss := newTrialTestSubspace(input)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+	require.NoError(t, hdlr(input.ctx, params.NewConfirmTrialProposal("Test", "description", 1)))
+
+	// a trial can only be confirmed once
+	require.Error(t, hdlr(input.ctx, params.NewConfirmTrialProposal("Test", "description", 1)))
+
+	params.EndBlocker(input.ctx.WithBlockHeight(5), input.keeper)
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+	require.Equal(t, uint64(2), input.keeper.GetNextTrialID(input.ctx))
+}
+
+func TestTrialParameterOnTrial(t *testing.T) {
+	input := newTestInput(t)
+	ss := newTrialTestSubspace(input)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	// a parameter cannot be changed by two trials at once
+	err := hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keyMaxValidators, "2")))
+	require.True(t, params.ErrParameterOnTrial.Is(err))
+	require.Equal(t, uint64(2), input.keeper.GetNextTrialID(input.ctx))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+
+	// after the trial is confirmed, the parameter can be changed on trial again
+	require.NoError(t, hdlr(input.ctx, params.NewConfirmTrialProposal("Test", "description", 1)))
+	require.NoError(t, hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keyMaxValidators, "2"))))
+}
+
+func TestTrialParameterChanged(t *testing.T) {
+	input := newTestInput(t)
+	ss := newTrialTestSubspace(input)
+	ss.Set(input.ctx, []byte(keySlashingRate), testParamsSlashingRate{DoubleSign: 5, Downtime: 5})
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))))
+	require.NoError(t, hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	// change a parameter on trial by a regular parameter change proposal
+	pcp := params.NewParameterChangeProposal("Test", "description", []params.ParamChange{
+		params.NewParamChange(testSubspace, keyMaxValidators, "3"),
+	})
+	require.NoError(t, hdlr(input.ctx, pcp))
+
+	ctx := input.ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	params.EndBlocker(ctx, input.keeper)
+
+	// the unchanged trial is reverted
+	var slashingRate testParamsSlashingRate
+	ss.Get(ctx, []byte(keySlashingRate), &slashingRate)
+	require.Equal(t, testParamsSlashingRate{DoubleSign: 5, Downtime: 5}, slashingRate)
+
+	// the changed trial is dropped without restoring the parameter
+	var param uint16
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(3), param)
+	_, found := input.keeper.GetTrial(ctx, 2)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, params.EventTypeRevertTrial, events[1].Type)
+	require.Len(t, events[1].Attributes, 2)
+	require.Equal(t, params.AttributeKeyRevertError, string(events[1].Attributes[1].Key))
+	require.Contains(t, string(events[1].Attributes[1].Value), params.ErrParameterChanged.Error())
+}
+
+func TestTrialUnsetParameter(t *testing.T) {
+	input := newTestInput(t)
+	ss := newTrialTestSubspace(input)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	tp := testTrialProposal(5, params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": "7"}`))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+	require.Equal(t, uint64(1), input.keeper.GetNextTrialID(input.ctx))
+}
+
+func TestTrialGenesis(t *testing.T) {
+	input := newTestInput(t)
+	newTrialTestSubspace(input)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testTrialProposal(5, params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	genState := params.ExportGenesis(input.ctx, input.keeper)
+	require.NoError(t, params.ValidateGenesis(genState))
+	require.Equal(t, uint64(2), genState.NextTrialID)
+	require.Len(t, genState.Trials, 1)
+
+	newInput := newTestInput(t)
+	params.InitGenesis(newInput.ctx, newInput.keeper, genState)
+	require.Equal(t, genState, params.ExportGenesis(newInput.ctx, newInput.keeper))
+	require.Len(t, newInput.keeper.GetDueTrials(newInput.ctx, 5), 1)
+
+	invalid := params.NewGenesisState(1, genState.Trials)
+	require.Error(t, params.ValidateGenesis(invalid))
+}
@@ -16,4 +16,6 @@ func init() {
 // RegisterCodec registers all necessary param module types with a given codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ParameterChangeProposal{}, "cosmos-sdk/ParameterChangeProposal", nil)
+	cdc.RegisterConcrete(TrialParameterChangeProposal{}, "cosmos-sdk/TrialParameterChangeProposal", nil)
+	cdc.RegisterConcrete(ConfirmTrialProposal{}, "cosmos-sdk/ConfirmTrialProposal", nil)
 }
@@ -6,10 +6,14 @@ import (
 
 // x/params module sentinel errors
 var (
-	ErrUnknownSubspace  = sdkerrors.Register(ModuleName, 1, "unknown subspace")
-	ErrSettingParameter = sdkerrors.Register(ModuleName, 2, "failed to set parameter")
-	ErrEmptyChanges     = sdkerrors.Register(ModuleName, 3, "submitted parameter changes are empty")
-	ErrEmptySubspace    = sdkerrors.Register(ModuleName, 4, "parameter subspace is empty")
-	ErrEmptyKey         = sdkerrors.Register(ModuleName, 5, "parameter key is empty")
-	ErrEmptyValue       = sdkerrors.Register(ModuleName, 6, "parameter value is empty")
+	ErrUnknownSubspace    = sdkerrors.Register(ModuleName, 1, "unknown subspace")
+	ErrSettingParameter   = sdkerrors.Register(ModuleName, 2, "failed to set parameter")
+	ErrEmptyChanges       = sdkerrors.Register(ModuleName, 3, "submitted parameter changes are empty")
+	ErrEmptySubspace      = sdkerrors.Register(ModuleName, 4, "parameter subspace is empty")
+	ErrEmptyKey           = sdkerrors.Register(ModuleName, 5, "parameter key is empty")
+	ErrEmptyValue         = sdkerrors.Register(ModuleName, 6, "parameter value is empty")
+	ErrInvalidTrialPeriod = sdkerrors.Register(ModuleName, 7, "trial period must be positive")
+	ErrUnknownTrial       = sdkerrors.Register(ModuleName, 8, "unknown trial")
+	ErrParameterOnTrial   = sdkerrors.Register(ModuleName, 9, "parameter is already on trial")
+	ErrParameterChanged   = sdkerrors.Register(ModuleName, 10, "parameter changed during trial")
 )
@@ -0,0 +1,13 @@
+package types
+
+// params module event types
+const (
+	EventTypeStartTrial   = "start_trial"
+	EventTypeConfirmTrial = "confirm_trial"
+	EventTypeRevertTrial  = "revert_trial"
+
+	AttributeKeyTrialID      = "trial_id"
+	AttributeKeyRevertHeight = "revert_height"
+	AttributeKeyRevertError  = "revert_error"
+	AttributeValueCategory   = ModuleName
+)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the params module's genesis state, i.e. the trials
+// which have not been reverted or confirmed yet. The parameters themselves are
+// part of the genesis state of the modules owning them.
+type GenesisState struct {
+	NextTrialID uint64  `json:"next_trial_id" yaml:"next_trial_id"`
+	Trials      []Trial `json:"trials" yaml:"trials"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(nextTrialID uint64, trials []Trial) GenesisState {
+	return GenesisState{NextTrialID: nextTrialID, Trials: trials}
+}
+
+// DefaultGenesisState returns a default params module genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, []Trial{})
+}
+
+// ValidateGenesis performs basic validation of params genesis data returning
+// an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[uint64]bool)
+	for _, trial := range data.Trials {
+		if seen[trial.ID] {
+			return fmt.Errorf("duplicate trial %d", trial.ID)
+		}
+		if trial.ID >= data.NextTrialID {
+			return fmt.Errorf("trial %d is not below the next trial id %d", trial.ID, data.NextTrialID)
+		}
+		if err := trial.Validate(); err != nil {
+			return err
+		}
+
+		seen[trial.ID] = true
+	}
+
+	return nil
+}
@@ -1,9 +1,42 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the name of the module
 	ModuleName = "params"
 
 	// RouterKey defines the routing key for a ParameterChangeProposal
 	RouterKey = "params"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys of the trials, which are stored in the params store alongside the
+// subspaces. Subspace keys are prefixed by the name of the subspace and hence
+// never start with these bytes.
+var (
+	TrialKeyPrefix      = []byte{0x00}
+	TrialQueueKeyPrefix = []byte{0x01}
+	NextTrialIDKey      = []byte{0x02}
 )
+
+// GetTrialKey returns the key of a trial.
+func GetTrialKey(id uint64) []byte {
+	return append(TrialKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTrialQueueKey returns the key of a trial in the queue of the trials to
+// revert, which is ordered by revert height.
+func GetTrialQueueKey(revertHeight int64, id uint64) []byte {
+	return append(GetTrialQueueHeightKey(revertHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTrialQueueHeightKey returns the prefix of the keys of the trials to revert
+// at a height.
+func GetTrialQueueHeightKey(revertHeight int64) []byte {
+	return append(TrialQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(revertHeight))...)
+}
@@ -0,0 +1,17 @@
+package types
+
+// querier keys
+const (
+	QueryTrials = "trials"
+	QueryTrial  = "trial"
+)
+
+// QueryTrialParams defines the params for querying a trial.
+type QueryTrialParams struct {
+	TrialID uint64 `json:"trial_id" yaml:"trial_id"`
+}
+
+// NewQueryTrialParams creates a new instance of QueryTrialParams.
+func NewQueryTrialParams(trialID uint64) QueryTrialParams {
+	return QueryTrialParams{TrialID: trialID}
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTrialChange defines the type for a TrialParameterChangeProposal
+	ProposalTypeTrialChange = "TrialParameterChange"
+
+	// ProposalTypeConfirmTrial defines the type for a ConfirmTrialProposal
+	ProposalTypeConfirmTrial = "ConfirmTrial"
+)
+
+// Assert the trial proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = TrialParameterChangeProposal{}
+	_ govtypes.Content = ConfirmTrialProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTrialChange)
+	govtypes.RegisterProposalTypeCodec(TrialParameterChangeProposal{}, "cosmos-sdk/TrialParameterChangeProposal")
+	govtypes.RegisterProposalType(ProposalTypeConfirmTrial)
+	govtypes.RegisterProposalTypeCodec(ConfirmTrialProposal{}, "cosmos-sdk/ConfirmTrialProposal")
+}
+
+// TrialParameterChangeProposal defines a proposal which applies multiple
+// parameter changes for a trial period of a number of blocks. The changes are
+// reverted at the end of the trial period unless the trial is confirmed by a
+// ConfirmTrialProposal.
+type TrialParameterChangeProposal struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Changes     []ParamChange `json:"changes" yaml:"changes"`
+	TrialPeriod int64         `json:"trial_period" yaml:"trial_period"`
+}
+
+func NewTrialParameterChangeProposal(
+	title, description string, changes []ParamChange, trialPeriod int64,
+) TrialParameterChangeProposal {
+
+	return TrialParameterChangeProposal{title, description, changes, trialPeriod}
+}
+
+// GetTitle returns the title of a trial parameter change proposal.
+func (tpcp TrialParameterChangeProposal) GetTitle() string { return tpcp.Title }
+
+// GetDescription returns the description of a trial parameter change proposal.
+func (tpcp TrialParameterChangeProposal) GetDescription() string { return tpcp.Description }
+
+// ProposalRoute returns the routing key of a trial parameter change proposal.
+func (tpcp TrialParameterChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a trial parameter change proposal.
+func (tpcp TrialParameterChangeProposal) ProposalType() string { return ProposalTypeTrialChange }
+
+// ValidateBasic validates the trial parameter change proposal
+func (tpcp TrialParameterChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(tpcp)
+	if err != nil {
+		return err
+	}
+
+	if tpcp.TrialPeriod <= 0 {
+		return ErrInvalidTrialPeriod
+	}
+
+	return ValidateChanges(tpcp.Changes)
+}
+
+// String implements the Stringer interface.
+func (tpcp TrialParameterChangeProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Trial Parameter Change Proposal:
+  Title:        %s
+  Description:  %s
+  Trial Period: %d
+  Changes:
+`, tpcp.Title, tpcp.Description, tpcp.TrialPeriod))
+
+	for _, pc := range tpcp.Changes {
+		b.WriteString(fmt.Sprintf(`    Param Change:
+      Subspace: %s
+      Key:      %s
+      Value:    %X
+`, pc.Subspace, pc.Key, pc.Value))
+	}
+
+	return b.String()
+}
+
+// ConfirmTrialProposal defines a proposal which confirms the parameter changes
+// of a trial, so that they are not reverted at the end of the trial period.
+type ConfirmTrialProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	TrialID     uint64 `json:"trial_id" yaml:"trial_id"`
+}
+
+func NewConfirmTrialProposal(title, description string, trialID uint64) ConfirmTrialProposal {
+	return ConfirmTrialProposal{title, description, trialID}
+}
+
+// GetTitle returns the title of a confirm trial proposal.
+func (ctp ConfirmTrialProposal) GetTitle() string { return ctp.Title }
+
+// GetDescription returns the description of a confirm trial proposal.
+func (ctp ConfirmTrialProposal) GetDescription() string { return ctp.Description }
+
+// ProposalRoute returns the routing key of a confirm trial proposal.
+func (ctp ConfirmTrialProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a confirm trial proposal.
+func (ctp ConfirmTrialProposal) ProposalType() string { return ProposalTypeConfirmTrial }
+
+// ValidateBasic validates the confirm trial proposal
+func (ctp ConfirmTrialProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ctp)
+}
+
+// String implements the Stringer interface.
+func (ctp ConfirmTrialProposal) String() string {
+	return fmt.Sprintf(`Confirm Trial Proposal:
+  Title:       %s
+  Description: %s
+  Trial ID:    %d
+`, ctp.Title, ctp.Description, ctp.TrialID)
+}
+
+// Trial defines parameter changes which have been applied by a trial parameter
+// change proposal and are reverted at the revert height unless confirmed.
+// Changes holds the stored values of the parameters after each change and
+// Reverts the values before each change.
+type Trial struct {
+	ID           uint64        `json:"id" yaml:"id"`
+	RevertHeight int64         `json:"revert_height" yaml:"revert_height"`
+	Changes      []ParamChange `json:"changes" yaml:"changes"`
+	Reverts      []ParamChange `json:"reverts" yaml:"reverts"`
+}
+
+func NewTrial(id uint64, revertHeight int64, changes, reverts []ParamChange) Trial {
+	return Trial{id, revertHeight, changes, reverts}
+}
+
+// Validate performs basic validation of the trial.
+func (t Trial) Validate() error {
+	if t.RevertHeight <= 0 {
+		return fmt.Errorf("invalid revert height of trial %d: %d", t.ID, t.RevertHeight)
+	}
+	if len(t.Changes) != len(t.Reverts) {
+		return fmt.Errorf("trial %d has %d changes but %d reverts", t.ID, len(t.Changes), len(t.Reverts))
+	}
+	if err := ValidateChanges(t.Changes); err != nil {
+		return err
+	}
+
+	return ValidateChanges(t.Reverts)
+}
+
+// String implements the Stringer interface.
+func (t Trial) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Trial %d:
+  Revert Height: %d
+  Changes:
+`, t.ID, t.RevertHeight))
+
+	for i, pc := range t.Changes {
+		b.WriteString(fmt.Sprintf(`    Param Change:
+      Subspace: %s
+      Key:      %s
+      Value:    %s
+      Previous: %s
+`, pc.Subspace, pc.Key, pc.Value, t.Reverts[i].Value))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,23 @@
+package randomness
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker derives the seed of the block from the seed of the previous block
+// and the entropy revealed in the previous block, and punishes the validators
+// which have missed too many reveals.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	seed := k.UpdateSeed(ctx)
+	k.HandleMissedReveals(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSeed,
+			sdk.NewAttribute(AttributeKeyHeight, fmt.Sprintf("%d", seed.Height)),
+			sdk.NewAttribute(AttributeKeySeed, seed.Value.String()),
+		),
+	)
+}
@@ -0,0 +1,89 @@
+package randomness
+
+// nolint
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	DefaultParamspace        = types.DefaultParamspace
+	DefaultHistoricalEntries = types.DefaultHistoricalEntries
+	DefaultSeedDelay         = types.DefaultSeedDelay
+	DefaultMaxMissedReveals  = types.DefaultMaxMissedReveals
+	EntropySize              = types.EntropySize
+	QueryParameters          = types.QueryParameters
+	QuerySeed                = types.QuerySeed
+	QueryHashChain           = types.QueryHashChain
+	TypeMsgCommitHashChain   = types.TypeMsgCommitHashChain
+	TypeMsgRevealEntropy     = types.TypeMsgRevealEntropy
+	EventTypeCommitHashChain = types.EventTypeCommitHashChain
+	EventTypeRevealEntropy   = types.EventTypeRevealEntropy
+	EventTypeSeed            = types.EventTypeSeed
+	EventTypeMissedReveals   = types.EventTypeMissedReveals
+	AttributeValueCategory   = types.AttributeValueCategory
+	AttributeKeyValidator    = types.AttributeKeyValidator
+	AttributeKeyTip          = types.AttributeKeyTip
+	AttributeKeyHeight       = types.AttributeKeyHeight
+	AttributeKeySeed         = types.AttributeKeySeed
+	AttributeKeyMissed       = types.AttributeKeyMissed
+)
+
+var (
+	// functions aliases
+	NewKeeper               = keeper.NewKeeper
+	NewQuerier              = keeper.NewQuerier
+	RegisterCodec           = types.RegisterCodec
+	NewGenesisState         = types.NewGenesisState
+	DefaultGenesisState     = types.DefaultGenesisState
+	NewParams               = types.NewParams
+	DefaultParams           = types.DefaultParams
+	ParamKeyTable           = types.ParamKeyTable
+	NewSeed                 = types.NewSeed
+	NextSeed                = types.NextSeed
+	NewHashChain            = types.NewHashChain
+	MixEntropy              = types.MixEntropy
+	GenerateHashChain       = types.GenerateHashChain
+	NewMsgCommitHashChain   = types.NewMsgCommitHashChain
+	NewMsgRevealEntropy     = types.NewMsgRevealEntropy
+	NewQuerySeedParams      = types.NewQuerySeedParams
+	NewQueryHashChainParams = types.NewQueryHashChainParams
+	GetSeedKey              = types.GetSeedKey
+	GetHashChainKey         = types.GetHashChainKey
+
+	// variable aliases
+	ModuleCdc                         = types.ModuleCdc
+	DefaultSlashFractionMissedReveals = types.DefaultSlashFractionMissedReveals
+	KeyHistoricalEntries              = types.KeyHistoricalEntries
+	KeySeedDelay                      = types.KeySeedDelay
+	KeyMaxMissedReveals               = types.KeyMaxMissedReveals
+	KeySlashFractionMissedReveals     = types.KeySlashFractionMissedReveals
+	SeedKeyPrefix                     = types.SeedKeyPrefix
+	HashChainKeyPrefix                = types.HashChainKeyPrefix
+	PendingEntropyKey                 = types.PendingEntropyKey
+	ErrNoSeed                         = types.ErrNoSeed
+	ErrNoHashChain                    = types.ErrNoHashChain
+	ErrInvalidEntropy                 = types.ErrInvalidEntropy
+	ErrRevealTooEarly                 = types.ErrRevealTooEarly
+	ErrUnknownValidator               = types.ErrUnknownValidator
+	ErrValidatorNotBonded             = types.ErrValidatorNotBonded
+	ErrSeedTooRecent                  = types.ErrSeedTooRecent
+)
+
+type (
+	Keeper               = keeper.Keeper
+	Hooks                = keeper.Hooks
+	GenesisState         = types.GenesisState
+	Params               = types.Params
+	Seed                 = types.Seed
+	HashChain            = types.HashChain
+	MsgCommitHashChain   = types.MsgCommitHashChain
+	MsgRevealEntropy     = types.MsgRevealEntropy
+	QuerySeedParams      = types.QuerySeedParams
+	QueryHashChainParams = types.QueryHashChainParams
+)
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+)
+
+// GetQueryCmd returns the query commands for the randomness module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the randomness module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQuerySeed(cdc),
+		GetCmdQueryHashChain(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryParams returns the command to query the randomness parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current randomness parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQuerySeed returns the command to query the seed of a block.
+func GetCmdQuerySeed(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "seed [height]",
+		Short: "Query the seed of a recent block, or of the latest block if no height is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var height int64
+			if len(args) == 1 {
+				h, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				if h <= 0 {
+					return fmt.Errorf("height must be positive: %d", h)
+				}
+				height = h
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySeedParams(height))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySeed)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var seed types.Seed
+			if err := cdc.UnmarshalJSON(res, &seed); err != nil {
+				return fmt.Errorf("failed to unmarshal seed: %w", err)
+			}
+
+			return cliCtx.PrintOutput(seed)
+		},
+	}
+}
+
+// GetCmdQueryHashChain returns the command to query the hash chain of a
+// validator.
+func GetCmdQueryHashChain(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "hash-chain [validator-addr]",
+		Short: "Query the hash chain a validator contributes entropy from",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHashChainParams(valAddr))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHashChain)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var hc types.HashChain
+			if err := cdc.UnmarshalJSON(res, &hc); err != nil {
+				return fmt.Errorf("failed to unmarshal hash chain: %w", err)
+			}
+
+			return cliCtx.PrintOutput(hc)
+		},
+	}
+}
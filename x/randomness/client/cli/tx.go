@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for the randomness module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Randomness transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdCommitHashChain(cdc),
+		GetCmdRevealEntropy(cdc),
+	)...)
+
+	return txCmd
+}
+
+// GetCmdCommitHashChain returns the command to commit the validator of the
+// sender to the tip of a new hash chain.
+func GetCmdCommitHashChain(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-hash-chain [tip]",
+		Short: "Commit the validator to the hex encoded tip of a new hash chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit the validator operated by the sender to the hex encoded tip of a new
+hash chain, replacing its current one. The values of the hash chain are revealed
+from the tip backwards, each being the preimage of the last one revealed.
+
+Example:
+$ %s tx %s commit-hash-chain 4f1b...e2 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			tip, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitHashChain(sdk.ValAddress(cliCtx.GetFromAddress()), tip)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevealEntropy returns the command to reveal the next value of the hash
+// chain of the validator of the sender.
+func GetCmdRevealEntropy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal [entropy]",
+		Short: "Reveal the hex encoded preimage of the tip of the validator's hash chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			entropy, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealEntropy(sdk.ValAddress(cliCtx.GetFromAddress()), entropy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package randomness
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the randomness module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, seed := range gs.Seeds {
+		k.SetSeed(ctx, seed)
+	}
+
+	for _, hc := range gs.HashChains {
+		k.SetHashChain(ctx, hc)
+	}
+
+	if len(gs.PendingEntropy) != 0 {
+		k.SetPendingEntropy(ctx, gs.PendingEntropy)
+	}
+}
+
+// ExportGenesis returns the randomness module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	seeds := []Seed{}
+	k.IterateSeeds(ctx, func(seed Seed) bool {
+		seeds = append(seeds, seed)
+		return false
+	})
+
+	hashChains := []HashChain{}
+	k.IterateHashChains(ctx, func(hc HashChain) bool {
+		hashChains = append(hashChains, hc)
+		return false
+	})
+
+	return NewGenesisState(k.GetParams(ctx), seeds, hashChains, k.GetPendingEntropy(ctx))
+}
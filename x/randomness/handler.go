@@ -0,0 +1,65 @@
+package randomness
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for the randomness module's messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCommitHashChain:
+			return handleMsgCommitHashChain(ctx, k, msg)
+
+		case MsgRevealEntropy:
+			return handleMsgRevealEntropy(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgCommitHashChain(ctx sdk.Context, k Keeper, msg MsgCommitHashChain) (*sdk.Result, error) {
+	if err := k.CommitHashChain(ctx, msg.Validator, msg.Tip); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCommitHashChain,
+			sdk.NewAttribute(AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(AttributeKeyTip, msg.Tip.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.Validator).String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevealEntropy(ctx sdk.Context, k Keeper, msg MsgRevealEntropy) (*sdk.Result, error) {
+	if err := k.RevealEntropy(ctx, msg.Validator, msg.Entropy); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRevealEntropy,
+			sdk.NewAttribute(AttributeKeyValidator, msg.Validator.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.Validator).String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
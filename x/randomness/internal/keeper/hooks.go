@@ -0,0 +1,60 @@
+// nolint
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+)
+
+// AfterValidatorBonded restarts counting the missed reveals of a validator with
+// a hash chain from the height at which it is bonded, since it could not reveal
+// entropy while it was not bonded.
+func (k Keeper) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	hc, found := k.GetHashChain(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	hc.RevealHeight = ctx.BlockHeight()
+	k.SetHashChain(ctx, hc)
+}
+
+// AfterValidatorRemoved removes the hash chain of a removed validator.
+func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	k.DeleteHashChain(ctx, valAddr)
+}
+
+//_________________________________________________________________________________________
+
+// Hooks wrapper struct for randomness keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ types.StakingHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterValidatorBonded(ctx, consAddr, valAddr)
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterValidatorRemoved(ctx, consAddr, valAddr)
+}
+
+// nolint - unused hooks
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                            {}
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
+func (h Hooks) AfterValidatorPhasedOut(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)       {}
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+)
+
+// Keeper defines the randomness module's keeper. It derives the seed of each
+// block and keeps the seeds of the recent blocks and the hash chains the
+// validators contribute entropy from.
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSpace    params.Subspace
+	stakingKeeper types.StakingKeeper
+}
+
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, stakingKeeper types.StakingKeeper,
+) Keeper {
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetSeed returns the seed of the block at the given height. It returns
+// ErrSeedTooRecent unless SeedDelay blocks have been built on top of the block,
+// and ErrNoSeed if the seed is not kept.
+//
+// NOTE: The seed of a block is known from the beginning of the block, so that
+// it must only be used to decide outcomes which have been committed to in an
+// earlier block.
+func (k Keeper) GetSeed(ctx sdk.Context, height int64) (types.Seed, error) {
+	if latest := k.latestSeedHeight(ctx); height > latest {
+		return types.Seed{}, sdkerrors.Wrapf(types.ErrSeedTooRecent, "height %d, latest readable height %d", height, latest)
+	}
+
+	seed, found := k.getSeed(ctx, height)
+	if !found {
+		return types.Seed{}, sdkerrors.Wrapf(types.ErrNoSeed, "height %d", height)
+	}
+
+	return seed, nil
+}
+
+// GetLatestSeed returns the seed of the latest block which can be read, i.e. of
+// the block SeedDelay blocks below the current block.
+func (k Keeper) GetLatestSeed(ctx sdk.Context) (seed types.Seed, found bool) {
+	return k.getSeed(ctx, k.latestSeedHeight(ctx))
+}
+
+func (k Keeper) latestSeedHeight(ctx sdk.Context) int64 {
+	return ctx.BlockHeight() - int64(k.SeedDelay(ctx))
+}
+
+func (k Keeper) getSeed(ctx sdk.Context, height int64) (seed types.Seed, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSeedKey(height))
+	if bz == nil {
+		return seed, false
+	}
+
+	return types.NewSeed(height, bz), true
+}
+
+// SetSeed sets the seed of a block.
+func (k Keeper) SetSeed(ctx sdk.Context, seed types.Seed) {
+	ctx.KVStore(k.storeKey).Set(types.GetSeedKey(seed.Height), seed.Value)
+}
+
+// IterateSeeds iterates over the seeds kept, ordered by height. If true is
+// returned from the callback, iteration is halted.
+func (k Keeper) IterateSeeds(ctx sdk.Context, cb func(types.Seed) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SeedKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		seed := types.NewSeed(int64(binary.BigEndian.Uint64(iterator.Key()[1:])), iterator.Value())
+		if cb(seed) {
+			break
+		}
+	}
+}
+
+// UpdateSeed derives the seed of the current block from the seed of the
+// previous block and the entropy revealed in the previous block.
+func (k Keeper) UpdateSeed(ctx sdk.Context) types.Seed {
+	height := ctx.BlockHeight()
+
+	var prev []byte
+	if seed, found := k.getSeed(ctx, height-1); found {
+		prev = seed.Value
+	}
+
+	seed := types.NextSeed(height, prev, k.GetPendingEntropy(ctx))
+	k.SetSeed(ctx, seed)
+	k.SetPendingEntropy(ctx, nil)
+
+	return seed
+}
+
+// SeedRetentionPolicy returns the retention policy of the seeds, which retains
+// the seeds of the HistoricalEntries most recent blocks.
+func (k Keeper) SeedRetentionPolicy() sdk.RetentionPolicy {
+	return sdk.NewHeightRetentionPolicy(
+		types.ModuleName+"/seeds", k.storeKey, types.SeedKeyPrefix,
+		func(ctx sdk.Context) int64 { return int64(k.HistoricalEntries(ctx)) },
+	)
+}
+
+// GetPendingEntropy returns the entropy revealed in the current block, which is
+// nil if none has been revealed.
+func (k Keeper) GetPendingEntropy(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.PendingEntropyKey)
+}
+
+// SetPendingEntropy sets the entropy revealed in the current block, or removes
+// it if nil.
+func (k Keeper) SetPendingEntropy(ctx sdk.Context, entropy []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if entropy == nil {
+		store.Delete(types.PendingEntropyKey)
+		return
+	}
+
+	store.Set(types.PendingEntropyKey, entropy)
+}
+
+// GetHashChain returns the hash chain of a validator.
+func (k Keeper) GetHashChain(ctx sdk.Context, valAddr sdk.ValAddress) (hc types.HashChain, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetHashChainKey(valAddr))
+	if bz == nil {
+		return hc, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &hc)
+	return hc, true
+}
+
+// SetHashChain sets the hash chain of a validator.
+func (k Keeper) SetHashChain(ctx sdk.Context, hc types.HashChain) {
+	ctx.KVStore(k.storeKey).Set(types.GetHashChainKey(hc.Validator), k.cdc.MustMarshalBinaryBare(hc))
+}
+
+// DeleteHashChain removes the hash chain of a validator.
+func (k Keeper) DeleteHashChain(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetHashChainKey(valAddr))
+}
+
+// IterateHashChains iterates over the hash chains of all validators. If true is
+// returned from the callback, iteration is halted.
+func (k Keeper) IterateHashChains(ctx sdk.Context, cb func(types.HashChain) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.HashChainKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var hc types.HashChain
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &hc)
+
+		if cb(hc) {
+			break
+		}
+	}
+}
+
+// CommitHashChain commits a validator to the tip of a new hash chain. Replacing
+// the hash chain of a validator does not restart counting its missed reveals.
+func (k Keeper) CommitHashChain(ctx sdk.Context, valAddr sdk.ValAddress, tip []byte) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return sdkerrors.Wrap(types.ErrUnknownValidator, valAddr.String())
+	}
+
+	revealHeight := ctx.BlockHeight()
+	if hc, found := k.GetHashChain(ctx, valAddr); found {
+		revealHeight = hc.RevealHeight
+	}
+
+	k.SetHashChain(ctx, types.NewHashChain(valAddr, tip, ctx.BlockHeight(), revealHeight))
+	return nil
+}
+
+// RevealEntropy mixes the entropy revealed by a bonded validator into the
+// entropy of the current block, which is used for the seed of the next block.
+// The entropy must be the preimage of the tip of the validator's hash chain,
+// which must not have been updated in the current block, and becomes the new
+// tip.
+func (k Keeper) RevealEntropy(ctx sdk.Context, valAddr sdk.ValAddress, entropy []byte) error {
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return sdkerrors.Wrap(types.ErrUnknownValidator, valAddr.String())
+	}
+	if !validator.IsBonded() {
+		return sdkerrors.Wrap(types.ErrValidatorNotBonded, valAddr.String())
+	}
+
+	hc, found := k.GetHashChain(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoHashChain, valAddr.String())
+	}
+	if hc.Height >= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrRevealTooEarly, "hash chain of %s updated at height %d", valAddr, hc.Height)
+	}
+	if !hc.IsPreimage(entropy) {
+		return sdkerrors.Wrapf(types.ErrInvalidEntropy, "tip %s", hc.Tip)
+	}
+
+	k.SetHashChain(ctx, types.NewHashChain(valAddr, entropy, ctx.BlockHeight(), ctx.BlockHeight()))
+	k.SetPendingEntropy(ctx, types.MixEntropy(k.GetPendingEntropy(ctx), entropy))
+	return nil
+}
+
+// HandleMissedReveals slashes and jails the bonded validators with a hash chain
+// which have not revealed entropy in the MaxMissedReveals blocks before the
+// current block, and removes their hash chains, so that withholding entropy to
+// bias the seeds has a cost. The validators have to commit to a new hash chain
+// once they are unjailed.
+func (k Keeper) HandleMissedReveals(ctx sdk.Context) {
+	maxMissed := k.MaxMissedReveals(ctx)
+	slashFraction := k.SlashFractionMissedReveals(ctx)
+
+	// the validators are punished after the iteration, as punishing them
+	// changes the hash chains
+	var missing []types.HashChain
+	k.IterateHashChains(ctx, func(hc types.HashChain) bool {
+		if hc.MissedReveals(ctx.BlockHeight()) >= maxMissed {
+			missing = append(missing, hc)
+		}
+		return false
+	})
+
+	// the infraction is attributed to the height of the last block whose
+	// validator set the validator was part of, like the downtime of validators
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	for _, hc := range missing {
+		validator := k.stakingKeeper.Validator(ctx, hc.Validator)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			continue
+		}
+
+		consAddr := validator.GetConsAddr()
+		k.stakingKeeper.Slash(ctx, consAddr, distributionHeight, validator.GetConsensusPower(), slashFraction)
+		k.stakingKeeper.Jail(ctx, consAddr)
+		k.DeleteHashChain(ctx, hc.Validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMissedReveals,
+				sdk.NewAttribute(types.AttributeKeyValidator, hc.Validator.String()),
+				sdk.NewAttribute(types.AttributeKeyMissed, fmt.Sprintf("%d", hc.MissedReveals(ctx.BlockHeight()))),
+			),
+		)
+
+		k.Logger(ctx).Info(fmt.Sprintf(
+			"validator %s slashed and jailed for missing %d reveals", hc.Validator, hc.MissedReveals(ctx.BlockHeight()),
+		))
+	}
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.RandomnessKeeper.SetParams(ctx, types.NewParams(2, 0, 2, sdk.NewDecWithPrec(1, 2)))
+
+	return app, ctx
+}
+
+func addValidator(app *simapp.SimApp, ctx sdk.Context, status sdk.BondStatus) sdk.ValAddress {
+	pk := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	validator := staking.NewValidator(valAddr, pk, staking.Description{}).UpdateStatus(status)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	return valAddr
+}
+
+func TestUpdateSeed(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.RandomnessKeeper
+
+	_, found := k.GetLatestSeed(ctx)
+	require.False(t, found)
+
+	seed1 := k.UpdateSeed(ctx)
+	require.Equal(t, types.NextSeed(1, nil, nil), seed1)
+
+	// the entropy revealed in a block is mixed into the seed of the next block
+	entropy := tmhash.Sum([]byte("entropy"))
+	k.SetPendingEntropy(ctx, entropy)
+
+	ctx = ctx.WithBlockHeight(2)
+	seed2 := k.UpdateSeed(ctx)
+	require.Equal(t, types.NextSeed(2, seed1.Value, entropy), seed2)
+	require.NotEqual(t, types.NextSeed(2, seed1.Value, nil), seed2)
+	require.Nil(t, k.GetPendingEntropy(ctx))
+
+	latest, found := k.GetLatestSeed(ctx)
+	require.True(t, found)
+	require.Equal(t, seed2, latest)
+
+	// only the seeds of the last HistoricalEntries blocks are retained
+	pm := module.NewPruningManager()
+	pm.RegisterRetentionPolicy(k.SeedRetentionPolicy())
+	pm.EndBlock(ctx)
+
+	ctx = ctx.WithBlockHeight(3)
+	seed3 := k.UpdateSeed(ctx)
+	_, err := k.GetSeed(ctx, 1)
+	require.NoError(t, err)
+
+	pm.EndBlock(ctx)
+	_, err = k.GetSeed(ctx, 1)
+	require.True(t, types.ErrNoSeed.Is(err))
+
+	var seeds []types.Seed
+	k.IterateSeeds(ctx, func(seed types.Seed) bool {
+		seeds = append(seeds, seed)
+		return false
+	})
+	require.Equal(t, []types.Seed{seed2, seed3}, seeds)
+}
+
+func TestRevealEntropy(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.RandomnessKeeper
+
+	valAddr := addValidator(app, ctx, sdk.Bonded)
+	chain := types.GenerateHashChain([]byte("secret"), 3)
+
+	require.True(t, types.ErrUnknownValidator.Is(k.CommitHashChain(ctx, sdk.ValAddress([]byte("unknown")), chain[0])))
+	require.True(t, types.ErrNoHashChain.Is(k.RevealEntropy(ctx, valAddr, chain[1])))
+
+	require.NoError(t, k.CommitHashChain(ctx, valAddr, chain[0]))
+
+	// entropy cannot be revealed in the block the tip was committed in
+	require.True(t, types.ErrRevealTooEarly.Is(k.RevealEntropy(ctx, valAddr, chain[1])))
+
+	ctx = ctx.WithBlockHeight(2)
+	require.True(t, types.ErrInvalidEntropy.Is(k.RevealEntropy(ctx, valAddr, chain[2])))
+	require.NoError(t, k.RevealEntropy(ctx, valAddr, chain[1]))
+	require.Equal(t, chain[1], k.GetPendingEntropy(ctx))
+
+	hc, found := k.GetHashChain(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, types.NewHashChain(valAddr, chain[1], 2, 2), hc)
+
+	// the entropy of each validator is mixed into the pending entropy
+	otherAddr := addValidator(app, ctx, sdk.Bonded)
+	otherChain := types.GenerateHashChain([]byte("other"), 2)
+	require.NoError(t, k.CommitHashChain(ctx.WithBlockHeight(1), otherAddr, otherChain[0]))
+	require.NoError(t, k.RevealEntropy(ctx, otherAddr, otherChain[1]))
+	require.Equal(t, types.MixEntropy(chain[1], otherChain[1]), k.GetPendingEntropy(ctx))
+
+	// only bonded validators contribute entropy
+	unbondedAddr := addValidator(app, ctx, sdk.Unbonded)
+	require.NoError(t, k.CommitHashChain(ctx.WithBlockHeight(1), unbondedAddr, chain[0]))
+	require.True(t, types.ErrValidatorNotBonded.Is(k.RevealEntropy(ctx, unbondedAddr, chain[1])))
+}
+
+func TestSeedDelay(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.RandomnessKeeper
+
+	params := k.GetParams(ctx)
+	params.SeedDelay = 1
+	k.SetParams(ctx, params)
+
+	seed1 := k.UpdateSeed(ctx)
+	// the seed of a block can only be read once SeedDelay blocks have been built
+	// on top of it
+	_, err := k.GetSeed(ctx, 1)
+	require.True(t, types.ErrSeedTooRecent.Is(err))
+	_, found := k.GetLatestSeed(ctx)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(2)
+	k.UpdateSeed(ctx)
+	_, err = k.GetSeed(ctx, 2)
+	require.True(t, types.ErrSeedTooRecent.Is(err))
+
+	seed, err := k.GetSeed(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, seed1, seed)
+
+	latest, found := k.GetLatestSeed(ctx)
+	require.True(t, found)
+	require.Equal(t, seed1, latest)
+}
+
+func TestHandleMissedReveals(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.RandomnessKeeper
+
+	valAddr := addValidator(app, ctx, sdk.Bonded)
+	chain := types.GenerateHashChain([]byte("secret"), 3)
+	require.NoError(t, k.CommitHashChain(ctx, valAddr, chain[0]))
+
+	// replacing the hash chain does not restart counting the missed reveals
+	otherAddr := addValidator(app, ctx, sdk.Bonded)
+	otherChain := types.GenerateHashChain([]byte("other"), 3)
+	require.NoError(t, k.CommitHashChain(ctx, otherAddr, otherChain[0]))
+	require.NoError(t, k.CommitHashChain(ctx.WithBlockHeight(2), otherAddr, otherChain[0]))
+
+	// validators which are not bonded are not expected to reveal
+	unbondedAddr := addValidator(app, ctx, sdk.Unbonded)
+	require.NoError(t, k.CommitHashChain(ctx, unbondedAddr, chain[0]))
+
+	// the validator reveals in the MaxMissedReveals blocks before block 4
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, k.RevealEntropy(ctx, valAddr, chain[1]))
+
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	k.HandleMissedReveals(ctx)
+
+	validator := app.StakingKeeper.Validator(ctx, valAddr)
+	require.False(t, validator.IsJailed())
+	_, found := k.GetHashChain(ctx, valAddr)
+	require.True(t, found)
+
+	unbonded := app.StakingKeeper.Validator(ctx, unbondedAddr)
+	require.False(t, unbonded.IsJailed())
+	_, found = k.GetHashChain(ctx, unbondedAddr)
+	require.True(t, found)
+
+	// the other validator missed the reveals in blocks 2 and 3
+	other := app.StakingKeeper.Validator(ctx, otherAddr)
+	require.True(t, other.IsJailed())
+	_, found = k.GetHashChain(ctx, otherAddr)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeMissedReveals, events[0].Type)
+	require.Equal(t, types.AttributeKeyValidator, string(events[0].Attributes[0].Key))
+	require.Equal(t, otherAddr.String(), string(events[0].Attributes[0].Value))
+	require.Equal(t, "2", string(events[0].Attributes[1].Value))
+
+	// a validator is only expected to reveal from the height it is bonded at
+	k.Hooks().AfterValidatorBonded(ctx, unbonded.GetConsAddr(), unbondedAddr)
+	hc, found := k.GetHashChain(ctx, unbondedAddr)
+	require.True(t, found)
+	require.Equal(t, int64(4), hc.RevealHeight)
+}
+
+func TestQuerier(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.RandomnessKeeper
+	querier := keeper.NewQuerier(k)
+
+	_, err := querier(ctx, []string{types.QuerySeed}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySeedParams(0))})
+	require.True(t, types.ErrNoSeed.Is(err))
+
+	seed := k.UpdateSeed(ctx)
+	for _, height := range []int64{0, 1} {
+		bz, err := querier(ctx, []string{types.QuerySeed}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySeedParams(height))})
+		require.NoError(t, err)
+
+		var res types.Seed
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &res))
+		require.Equal(t, seed, res)
+	}
+
+	valAddr := addValidator(app, ctx, sdk.Bonded)
+	tip := types.GenerateHashChain([]byte("secret"), 1)[0]
+	require.NoError(t, k.CommitHashChain(ctx, valAddr, tip))
+
+	bz, err := querier(ctx, []string{types.QueryHashChain}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryHashChainParams(valAddr))})
+	require.NoError(t, err)
+
+	var hc types.HashChain
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &hc))
+	require.Equal(t, types.NewHashChain(valAddr, tip, 1, 1), hc)
+
+	bz, err = querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var params types.Params
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &params))
+	require.Equal(t, k.GetParams(ctx), params)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/randomness/internal/types"
+)
+
+// HistoricalEntries returns the number of recent blocks whose seeds are kept.
+func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyHistoricalEntries, &res)
+	return
+}
+
+// SeedDelay returns the number of blocks which have to be built on top of a
+// block before its seed can be read.
+func (k Keeper) SeedDelay(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeySeedDelay, &res)
+	return
+}
+
+// MaxMissedReveals returns the number of consecutive blocks a validator can
+// miss to reveal entropy in before it is slashed and jailed.
+func (k Keeper) MaxMissedReveals(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.KeyMaxMissedReveals, &res)
+	return
+}
+
+// SlashFractionMissedReveals returns the fraction of the stake of a validator
+// slashed for missing too many reveals.
+func (k Keeper) SlashFractionMissedReveals(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySlashFractionMissedReveals, &res)
+	return
+}
+
+// GetParams returns the total set of randomness parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the randomness parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
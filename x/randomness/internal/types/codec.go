@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the randomness module's codec.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// randomness module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCommitHashChain{}, "cosmos-sdk/MsgCommitHashChain", nil)
+	cdc.RegisterConcrete(MsgRevealEntropy{}, "cosmos-sdk/MsgRevealEntropy", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/randomness module sentinel errors
+var (
+	ErrNoSeed             = sdkerrors.Register(ModuleName, 1, "no seed for height")
+	ErrNoHashChain        = sdkerrors.Register(ModuleName, 2, "no hash chain committed")
+	ErrInvalidEntropy     = sdkerrors.Register(ModuleName, 3, "entropy is not the preimage of the hash chain tip")
+	ErrRevealTooEarly     = sdkerrors.Register(ModuleName, 4, "hash chain already updated in this block")
+	ErrUnknownValidator   = sdkerrors.Register(ModuleName, 5, "unknown validator")
+	ErrValidatorNotBonded = sdkerrors.Register(ModuleName, 6, "validator is not bonded")
+	ErrSeedTooRecent      = sdkerrors.Register(ModuleName, 7, "seed is too recent to be read")
+)
@@ -0,0 +1,16 @@
+package types
+
+// randomness module events
+const (
+	EventTypeCommitHashChain = "commit_hash_chain"
+	EventTypeRevealEntropy   = "reveal_entropy"
+	EventTypeSeed            = "seed"
+	EventTypeMissedReveals   = "missed_reveals"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyValidator  = "validator"
+	AttributeKeyTip        = "tip"
+	AttributeKeyHeight     = "height"
+	AttributeKeySeed       = "seed"
+	AttributeKeyMissed     = "missed"
+)
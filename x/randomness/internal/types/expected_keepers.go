@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper defines the expected staking keeper, which is used to check
+// that entropy is contributed by bonded validators and to punish the
+// validators missing to reveal it.
+type StakingKeeper interface {
+	Validator(sdk.Context, sdk.ValAddress) stakingexported.ValidatorI
+	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
+	Jail(sdk.Context, sdk.ConsAddress)
+}
+
+// StakingHooks event hooks for staking validator object (noalias)
+type StakingHooks interface {
+	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
+	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)  // Must be called when a validator is bonded
+}
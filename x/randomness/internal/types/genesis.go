@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// GenesisState defines the randomness module's genesis state. PendingEntropy
+// is the entropy revealed in the last block, which is mixed into the seed of
+// the next block.
+type GenesisState struct {
+	Params         Params           `json:"params" yaml:"params"`
+	Seeds          []Seed           `json:"seeds" yaml:"seeds"`
+	HashChains     []HashChain      `json:"hash_chains" yaml:"hash_chains"`
+	PendingEntropy tmbytes.HexBytes `json:"pending_entropy" yaml:"pending_entropy"`
+}
+
+func NewGenesisState(p Params, seeds []Seed, hashChains []HashChain, pendingEntropy []byte) GenesisState {
+	return GenesisState{
+		Params:         p,
+		Seeds:          seeds,
+		HashChains:     hashChains,
+		PendingEntropy: pendingEntropy,
+	}
+}
+
+// DefaultGenesisState returns the randomness module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []Seed{}, []HashChain{}, nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	heights := make(map[int64]bool, len(gs.Seeds))
+	for _, s := range gs.Seeds {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if heights[s.Height] {
+			return fmt.Errorf("duplicate seed at height %d", s.Height)
+		}
+		heights[s.Height] = true
+	}
+
+	validators := make(map[string]bool, len(gs.HashChains))
+	for _, hc := range gs.HashChains {
+		if err := hc.Validate(); err != nil {
+			return err
+		}
+		if validators[hc.Validator.String()] {
+			return fmt.Errorf("duplicate hash chain of %s", hc.Validator)
+		}
+		validators[hc.Validator.String()] = true
+	}
+
+	if len(gs.PendingEntropy) != 0 && len(gs.PendingEntropy) != EntropySize {
+		return fmt.Errorf("invalid size of pending entropy: %d", len(gs.PendingEntropy))
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "randomness"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	SeedKeyPrefix      = []byte{0x01}
+	HashChainKeyPrefix = []byte{0x02}
+	PendingEntropyKey  = []byte{0x03}
+)
+
+// GetSeedKey returns the key of the seed of a block.
+func GetSeedKey(height int64) []byte {
+	return append(SeedKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetHashChainKey returns the key of the hash chain of a validator.
+func GetHashChainKey(valAddr sdk.ValAddress) []byte {
+	return append(HashChainKeyPrefix, valAddr.Bytes()...)
+}
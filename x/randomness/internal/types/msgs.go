@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Message types for the randomness module
+const (
+	TypeMsgCommitHashChain = "commit_hash_chain"
+	TypeMsgRevealEntropy   = "reveal_entropy"
+)
+
+var (
+	_ sdk.Msg = MsgCommitHashChain{}
+	_ sdk.Msg = MsgRevealEntropy{}
+)
+
+// MsgCommitHashChain defines an sdk.Msg type that commits a validator to the
+// tip of a new hash chain, replacing its current one. It is signed by the
+// operator of the validator.
+type MsgCommitHashChain struct {
+	Validator sdk.ValAddress   `json:"validator" yaml:"validator"`
+	Tip       tmbytes.HexBytes `json:"tip" yaml:"tip"`
+}
+
+func NewMsgCommitHashChain(valAddr sdk.ValAddress, tip []byte) MsgCommitHashChain {
+	return MsgCommitHashChain{Validator: valAddr, Tip: tip}
+}
+
+// Route returns the MsgCommitHashChain's route.
+func (m MsgCommitHashChain) Route() string { return RouterKey }
+
+// Type returns the MsgCommitHashChain's type.
+func (m MsgCommitHashChain) Type() string { return TypeMsgCommitHashChain }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgCommitHashChain.
+func (m MsgCommitHashChain) ValidateBasic() error {
+	if m.Validator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Validator.String())
+	}
+
+	return validateEntropySize(m.Tip)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgCommitHashChain message.
+func (m MsgCommitHashChain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the operator of the validator as the single expected
+// signer for a MsgCommitHashChain.
+func (m MsgCommitHashChain) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(m.Validator)}
+}
+
+// MsgRevealEntropy defines an sdk.Msg type that contributes entropy from the
+// hash chain of a validator to the seed of the next block. The entropy must be
+// the preimage of the tip of the hash chain. It is signed by the operator of
+// the validator.
+type MsgRevealEntropy struct {
+	Validator sdk.ValAddress   `json:"validator" yaml:"validator"`
+	Entropy   tmbytes.HexBytes `json:"entropy" yaml:"entropy"`
+}
+
+func NewMsgRevealEntropy(valAddr sdk.ValAddress, entropy []byte) MsgRevealEntropy {
+	return MsgRevealEntropy{Validator: valAddr, Entropy: entropy}
+}
+
+// Route returns the MsgRevealEntropy's route.
+func (m MsgRevealEntropy) Route() string { return RouterKey }
+
+// Type returns the MsgRevealEntropy's type.
+func (m MsgRevealEntropy) Type() string { return TypeMsgRevealEntropy }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRevealEntropy.
+func (m MsgRevealEntropy) ValidateBasic() error {
+	if m.Validator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Validator.String())
+	}
+
+	return validateEntropySize(m.Entropy)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgRevealEntropy message.
+func (m MsgRevealEntropy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the operator of the validator as the single expected
+// signer for a MsgRevealEntropy.
+func (m MsgRevealEntropy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(m.Validator)}
+}
+
+func validateEntropySize(bz []byte) error {
+	if len(bz) != EntropySize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("expected %d bytes, got %d", EntropySize, len(bz)))
+	}
+
+	return nil
+}
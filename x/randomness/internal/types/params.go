@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameter values
+const (
+	DefaultParamspace               = ModuleName
+	DefaultHistoricalEntries uint64 = 100
+	DefaultSeedDelay         uint64 = 1
+	DefaultMaxMissedReveals  int64  = 100
+)
+
+// DefaultSlashFractionMissedReveals is the default fraction of the stake of a
+// validator slashed for missing too many reveals.
+var DefaultSlashFractionMissedReveals = sdk.NewDecWithPrec(1, 3)
+
+// Parameter store keys
+var (
+	KeyHistoricalEntries          = []byte("HistoricalEntries")
+	KeySeedDelay                  = []byte("SeedDelay")
+	KeyMaxMissedReveals           = []byte("MaxMissedReveals")
+	KeySlashFractionMissedReveals = []byte("SlashFractionMissedReveals")
+)
+
+// Params defines the total set of parameters for the randomness module
+type Params struct {
+	// HistoricalEntries is the number of recent blocks whose seeds are kept.
+	HistoricalEntries uint64 `json:"historical_entries" yaml:"historical_entries"`
+	// SeedDelay is the number of blocks which have to be built on top of a block
+	// before keepers can read its seed.
+	SeedDelay uint64 `json:"seed_delay" yaml:"seed_delay"`
+	// MaxMissedReveals is the number of consecutive blocks a bonded validator
+	// with a hash chain can miss to reveal entropy in before it is slashed and
+	// jailed.
+	MaxMissedReveals int64 `json:"max_missed_reveals" yaml:"max_missed_reveals"`
+	// SlashFractionMissedReveals is the fraction of the stake of a validator
+	// slashed for missing MaxMissedReveals reveals.
+	SlashFractionMissedReveals sdk.Dec `json:"slash_fraction_missed_reveals" yaml:"slash_fraction_missed_reveals"`
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	historicalEntries, seedDelay uint64, maxMissedReveals int64, slashFractionMissedReveals sdk.Dec,
+) Params {
+
+	return Params{
+		HistoricalEntries:          historicalEntries,
+		SeedDelay:                  seedDelay,
+		MaxMissedReveals:           maxMissedReveals,
+		SlashFractionMissedReveals: slashFractionMissedReveals,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
+		params.NewParamSetPair(KeySeedDelay, &p.SeedDelay, validateSeedDelay),
+		params.NewParamSetPair(KeyMaxMissedReveals, &p.MaxMissedReveals, validateMaxMissedReveals),
+		params.NewParamSetPair(KeySlashFractionMissedReveals, &p.SlashFractionMissedReveals, validateSlashFractionMissedReveals),
+	}
+}
+
+// DefaultParams returns the default parameters for the randomness module.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultHistoricalEntries, DefaultSeedDelay, DefaultMaxMissedReveals, DefaultSlashFractionMissedReveals,
+	)
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
+		return err
+	}
+	if err := validateSeedDelay(p.SeedDelay); err != nil {
+		return err
+	}
+	if err := validateMaxMissedReveals(p.MaxMissedReveals); err != nil {
+		return err
+	}
+	if err := validateSlashFractionMissedReveals(p.SlashFractionMissedReveals); err != nil {
+		return err
+	}
+
+	if p.SeedDelay >= p.HistoricalEntries {
+		return fmt.Errorf(
+			"seed delay must be less than historical entries, so that seeds can be read: %d >= %d",
+			p.SeedDelay, p.HistoricalEntries,
+		)
+	}
+
+	return nil
+}
+
+func validateHistoricalEntries(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("historical entries must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSeedDelay(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxMissedReveals(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max missed reveals must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionMissedReveals(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("slash fraction for missed reveals cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction for missed reveals too large: %s", v)
+	}
+
+	return nil
+}
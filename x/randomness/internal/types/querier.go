@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the randomness module
+const (
+	QueryParameters = "parameters"
+	QuerySeed       = "seed"
+	QueryHashChain  = "hash_chain"
+)
+
+// QuerySeedParams defines the parameters necessary for querying the seed of a
+// block. A zero height queries the latest seed.
+type QuerySeedParams struct {
+	Height int64 `json:"height" yaml:"height"`
+}
+
+func NewQuerySeedParams(height int64) QuerySeedParams {
+	return QuerySeedParams{Height: height}
+}
+
+// QueryHashChainParams defines the parameters necessary for querying the hash
+// chain of a validator.
+type QueryHashChainParams struct {
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+}
+
+func NewQueryHashChainParams(valAddr sdk.ValAddress) QueryHashChainParams {
+	return QueryHashChainParams{Validator: valAddr}
+}
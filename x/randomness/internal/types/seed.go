@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EntropySize is the size of the entropy contributed by validators and of the
+// seeds.
+const EntropySize = tmhash.Size
+
+// Seed defines the randomness of a block. It is derived at the beginning of the
+// block from the seed of the previous block and the entropy revealed by the
+// validators in the previous block. The hash of the previous block is not used,
+// as its proposer could grind it.
+type Seed struct {
+	Height int64            `json:"height" yaml:"height"`
+	Value  tmbytes.HexBytes `json:"value" yaml:"value"`
+}
+
+func NewSeed(height int64, value []byte) Seed {
+	return Seed{
+		Height: height,
+		Value:  value,
+	}
+}
+
+// NextSeed returns the seed of the block at the given height derived from the
+// seed of the previous block, which is nil if unknown, and the entropy revealed
+// in the previous block.
+func NextSeed(height int64, prev, entropy []byte) Seed {
+	bz := make([]byte, 0, len(prev)+len(entropy))
+	bz = append(bz, prev...)
+	bz = append(bz, entropy...)
+
+	return NewSeed(height, tmhash.Sum(bz))
+}
+
+// Validate performs basic validation of the seed.
+func (s Seed) Validate() error {
+	if s.Height <= 0 {
+		return fmt.Errorf("invalid height of seed: %d", s.Height)
+	}
+	if len(s.Value) != EntropySize {
+		return fmt.Errorf("invalid size of seed at height %d: %d", s.Height, len(s.Value))
+	}
+
+	return nil
+}
+
+func (s Seed) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// HashChain defines the hash chain a validator contributes entropy from. Each
+// contribution is the preimage of the tip, which then becomes the new tip, so
+// that the entropy of a validator is determined by its commitment and cannot be
+// chosen when it is revealed. Height is the height at which the tip was last
+// updated, and RevealHeight the height of the last reveal of the validator, or
+// of its first commitment or bonding since, from which its missed reveals are
+// counted.
+type HashChain struct {
+	Validator    sdk.ValAddress   `json:"validator" yaml:"validator"`
+	Tip          tmbytes.HexBytes `json:"tip" yaml:"tip"`
+	Height       int64            `json:"height" yaml:"height"`
+	RevealHeight int64            `json:"reveal_height" yaml:"reveal_height"`
+}
+
+func NewHashChain(valAddr sdk.ValAddress, tip []byte, height, revealHeight int64) HashChain {
+	return HashChain{
+		Validator:    valAddr,
+		Tip:          tip,
+		Height:       height,
+		RevealHeight: revealHeight,
+	}
+}
+
+// MissedReveals returns the number of blocks before the given height in which
+// the validator of the hash chain could have revealed entropy but did not.
+func (hc HashChain) MissedReveals(height int64) int64 {
+	return height - 1 - hc.RevealHeight
+}
+
+// IsPreimage returns true if the given entropy is the preimage of the tip of
+// the hash chain.
+func (hc HashChain) IsPreimage(entropy []byte) bool {
+	return bytes.Equal(tmhash.Sum(entropy), hc.Tip)
+}
+
+// Validate performs basic validation of the hash chain.
+func (hc HashChain) Validate() error {
+	if hc.Validator.Empty() {
+		return errors.New("hash chain has no validator")
+	}
+	if len(hc.Tip) != EntropySize {
+		return fmt.Errorf("invalid size of hash chain tip of %s: %d", hc.Validator, len(hc.Tip))
+	}
+	if hc.Height <= 0 {
+		return fmt.Errorf("invalid height of hash chain of %s: %d", hc.Validator, hc.Height)
+	}
+	if hc.RevealHeight <= 0 {
+		return fmt.Errorf("invalid reveal height of hash chain of %s: %d", hc.Validator, hc.RevealHeight)
+	}
+
+	return nil
+}
+
+func (hc HashChain) String() string {
+	out, _ := yaml.Marshal(hc)
+	return string(out)
+}
+
+// MixEntropy returns the entropy of a block after the given entropy has been
+// revealed. The revealed entropy is combined by XOR, so that the result does
+// not depend on the order of the transactions in the block.
+func MixEntropy(entropy, revealed []byte) []byte {
+	mixed := make([]byte, EntropySize)
+	copy(mixed, entropy)
+	for i := range mixed {
+		mixed[i] ^= revealed[i]
+	}
+
+	return mixed
+}
+
+// GenerateHashChain returns the values of a hash chain of the given length
+// starting from a secret, ordered from the tip to be committed to the last
+// value to be revealed. The secret itself is never revealed.
+func GenerateHashChain(secret []byte, length int) [][]byte {
+	values := make([][]byte, length)
+	value := tmhash.Sum(secret)
+	for i := length - 1; i >= 0; i-- {
+		values[i] = value
+		value = tmhash.Sum(value)
+	}
+
+	return values
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenerateHashChain(t *testing.T) {
+	values := GenerateHashChain([]byte("secret"), 3)
+	require.Len(t, values, 3)
+
+	// each value is the preimage of the previous one
+	hc := NewHashChain(sdk.ValAddress([]byte("validator")), values[0], 1, 1)
+	for _, v := range values[1:] {
+		require.True(t, hc.IsPreimage(v))
+		require.False(t, hc.IsPreimage(values[0]))
+		hc.Tip = v
+	}
+}
+
+func TestMixEntropy(t *testing.T) {
+	values := GenerateHashChain([]byte("secret"), 2)
+
+	mixed := MixEntropy(nil, values[0])
+	require.Equal(t, values[0], mixed)
+
+	// the order in which entropy is revealed does not matter
+	require.Equal(t, MixEntropy(mixed, values[1]), MixEntropy(MixEntropy(nil, values[1]), values[0]))
+	require.Equal(t, values[0], mixed, "entropy must not be mixed in place")
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	values := GenerateHashChain([]byte("secret"), 2)
+	seed := NewSeed(1, values[0])
+	hc := NewHashChain(sdk.ValAddress([]byte("validator")), values[0], 1, 1)
+
+	require.NoError(t, DefaultGenesisState().Validate())
+	require.NoError(t, NewGenesisState(DefaultParams(), []Seed{seed}, []HashChain{hc}, values[1]).Validate())
+	require.Error(t, NewGenesisState(NewParams(0, 0, 1, sdk.ZeroDec()), nil, nil, nil).Validate())
+	require.Error(t, NewGenesisState(NewParams(1, 1, 1, sdk.ZeroDec()), nil, nil, nil).Validate())
+	require.Error(t, NewGenesisState(NewParams(1, 0, 0, sdk.ZeroDec()), nil, nil, nil).Validate())
+	require.Error(t, NewGenesisState(NewParams(1, 0, 1, sdk.NewDec(2)), nil, nil, nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), nil, []HashChain{NewHashChain(hc.Validator, hc.Tip, 1, 0)}, nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), []Seed{seed, seed}, nil, nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), []Seed{NewSeed(0, values[0])}, nil, nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), []Seed{NewSeed(1, []byte{0x01})}, nil, nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), nil, []HashChain{hc, hc}, nil).Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), nil, nil, []byte{0x01}).Validate())
+}
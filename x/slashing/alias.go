@@ -18,6 +18,7 @@ const (
 	QueryParameters             = types.QueryParameters
 	QuerySigningInfo            = types.QuerySigningInfo
 	QuerySigningInfos           = types.QuerySigningInfos
+	QueryValidatorSigningInfo   = types.QueryValidatorSigningInfo
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
@@ -59,6 +60,7 @@ var (
 	DefaultParams                            = types.DefaultParams
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
+	NewQueryValidatorSigningInfoParams       = types.NewQueryValidatorSigningInfoParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
 
 	// variable aliases
@@ -77,13 +79,14 @@ var (
 )
 
 type (
-	Hooks                   = keeper.Hooks
-	Keeper                  = keeper.Keeper
-	GenesisState            = types.GenesisState
-	MissedBlock             = types.MissedBlock
-	MsgUnjail               = types.MsgUnjail
-	Params                  = types.Params
-	QuerySigningInfoParams  = types.QuerySigningInfoParams
-	QuerySigningInfosParams = types.QuerySigningInfosParams
-	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	Hooks                           = keeper.Hooks
+	Keeper                          = keeper.Keeper
+	GenesisState                    = types.GenesisState
+	MissedBlock                     = types.MissedBlock
+	MsgUnjail                       = types.MsgUnjail
+	Params                          = types.Params
+	QuerySigningInfoParams          = types.QuerySigningInfoParams
+	QuerySigningInfosParams         = types.QuerySigningInfosParams
+	QueryValidatorSigningInfoParams = types.QueryValidatorSigningInfoParams
+	ValidatorSigningInfo            = types.ValidatorSigningInfo
 )
@@ -40,11 +40,16 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 // GetCmdQuerySigningInfo implements the command to query signing info.
 func GetCmdQuerySigningInfo(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "signing-info [validator-conspub]",
+		Use:   "signing-info [validator-conspub|validator-addr]",
 		Short: "Query a validator's signing information",
 		Long: strings.TrimSpace(`Use a validators' consensus public key to find the signing-info for that validator:
 
 $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+
+Alternatively, the validator may be given by either its operator address or the
+account address of its operator:
+
+$ <appcli> query slashing signing-info cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 `),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,7 +57,12 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 
 			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
 			if err != nil {
-				return err
+				valAddr, valErr := sdk.ValAddressFromAccOrValBech32(args[0])
+				if valErr != nil {
+					return err
+				}
+
+				return queryValidatorSigningInfo(cliCtx, cdc, valAddr)
 			}
 
 			consAddr := sdk.ConsAddress(pk.Address())
@@ -74,6 +84,28 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// queryValidatorSigningInfo queries and prints the signing info of the
+// validator given by its operator address.
+func queryValidatorSigningInfo(cliCtx context.CLIContext, cdc *codec.Codec, valAddr sdk.ValAddress) error {
+	bz, err := cdc.MarshalJSON(types.NewQueryValidatorSigningInfoParams(valAddr))
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSigningInfo)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var signingInfo types.ValidatorSigningInfo
+	if err := cdc.UnmarshalJSON(res, &signingInfo); err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(signingInfo)
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -29,14 +29,31 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	).Methods("GET")
 }
 
-// http request handler to query signing info
+// http request handler to query signing info, the validator may be given by
+// either its consensus public key, its operator address or the account address
+// of its operator
 func signingInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
+
+		var (
+			params interface{}
+			route  string
+		)
+
 		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, vars["validatorPubKey"])
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
+		if err == nil {
+			params = types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address()))
+			route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfo)
+		} else {
+			valAddr, valErr := sdk.ValAddressFromAccOrValBech32(vars["validatorPubKey"])
+			if valErr != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			params = types.NewQueryValidatorSigningInfoParams(valAddr)
+			route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSigningInfo)
 		}
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -44,15 +61,12 @@ func signingInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		params := types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address()))
-
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfo)
 		res, height, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -23,6 +23,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
 
+		case types.QueryValidatorSigningInfo:
+			return queryValidatorSigningInfo(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -61,6 +64,34 @@ func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return res, nil
 }
 
+func queryValidatorSigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorSigningInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validator := k.sk.Validator(ctx, params.ValidatorAddr)
+	if validator == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorForAddress, params.ValidatorAddr.String())
+	}
+
+	consAddr := validator.GetConsAddr()
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoSigningInfoFound, consAddr.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, signingInfo)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QuerySigningInfosParams
 
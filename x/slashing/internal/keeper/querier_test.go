@@ -7,7 +7,9 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestNewQuerier(t *testing.T) {
@@ -36,3 +38,41 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keeper.GetParams(ctx), params)
 }
+
+func TestQueryValidatorSigningInfo(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, sk, _, keeper := CreateTestInput(t, TestParams())
+	addr, val := Addrs[0], Pks[0]
+	amt := sdk.TokensFromConsensusPower(100)
+	sh := staking.NewHandler(sk)
+
+	_, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, sk)
+
+	consAddr := sdk.ConsAddress(val.Address())
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+
+	bz, err := cdc.MarshalJSON(types.NewQueryValidatorSigningInfoParams(addr))
+	require.NoError(t, err)
+	query := abci.RequestQuery{
+		Path: "/custom/slashing/validatorSigningInfo",
+		Data: bz,
+	}
+
+	res, err := queryValidatorSigningInfo(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var signingInfo types.ValidatorSigningInfo
+	require.NoError(t, cdc.UnmarshalJSON(res, &signingInfo))
+	require.Equal(t, info, signingInfo)
+
+	// unknown validators are rejected
+	bz, err = cdc.MarshalJSON(types.NewQueryValidatorSigningInfoParams(Addrs[1]))
+	require.NoError(t, err)
+	query.Data = bz
+
+	_, err = queryValidatorSigningInfo(ctx, query, keeper)
+	require.True(t, types.ErrNoValidatorForAddress.Is(err))
+}
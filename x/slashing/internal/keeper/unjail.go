@@ -41,7 +41,7 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	}
 
 	// cannot be unjailed until out of jail
-	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+	if ctx.HeaderTime().Before(info.JailedUntil) {
 		return types.ErrValidatorJailed
 	}
 
@@ -8,9 +8,10 @@ import (
 
 // Query endpoints supported by the slashing querier
 const (
-	QueryParameters   = "parameters"
-	QuerySigningInfo  = "signingInfo"
-	QuerySigningInfos = "signingInfos"
+	QueryParameters           = "parameters"
+	QuerySigningInfo          = "signingInfo"
+	QuerySigningInfos         = "signingInfos"
+	QueryValidatorSigningInfo = "validatorSigningInfo"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
@@ -24,6 +25,17 @@ func NewQuerySigningInfoParams(consAddr sdk.ConsAddress) QuerySigningInfoParams
 	return QuerySigningInfoParams{consAddr}
 }
 
+// QueryValidatorSigningInfoParams defines the params for the following queries:
+// - 'custom/slashing/validatorSigningInfo'
+type QueryValidatorSigningInfoParams struct {
+	ValidatorAddr sdk.ValAddress
+}
+
+// NewQueryValidatorSigningInfoParams creates a new QueryValidatorSigningInfoParams instance
+func NewQueryValidatorSigningInfoParams(valAddr sdk.ValAddress) QueryValidatorSigningInfoParams {
+	return QueryValidatorSigningInfoParams{valAddr}
+}
+
 // QuerySigningInfosParams defines the params for the following queries:
 // - 'custom/slashing/signingInfos'
 type QuerySigningInfosParams struct {
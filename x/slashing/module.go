@@ -20,12 +20,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule              = AppModule{}
+	_ module.AppModuleBasic         = AppModuleBasic{}
+	_ module.AppModuleSimulation    = AppModule{}
+	_ module.HasGenesisDependencies = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the slashing module.
@@ -134,6 +136,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns the staking module, whose validators are read on
+// genesis initialization of the slashing module.
+func (AppModule) GenesisDependencies() []string {
+	return []string{stakingtypes.ModuleName}
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the slashing
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
@@ -0,0 +1,73 @@
+package spendlimit
+
+// nolint
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/types"
+)
+
+const (
+	ModuleName                    = types.ModuleName
+	StoreKey                      = types.StoreKey
+	RouterKey                     = types.RouterKey
+	QuerierRoute                  = types.QuerierRoute
+	DefaultParamspace             = types.DefaultParamspace
+	DefaultOverrideDelay          = types.DefaultOverrideDelay
+	QueryParameters               = types.QueryParameters
+	QuerySpendingLimit            = types.QuerySpendingLimit
+	QueryPendingSpendingLimit     = types.QueryPendingSpendingLimit
+	TypeMsgSetSpendingLimit       = types.TypeMsgSetSpendingLimit
+	TypeMsgConfirmSpendingLimit   = types.TypeMsgConfirmSpendingLimit
+	EventTypeSetSpendingLimit     = types.EventTypeSetSpendingLimit
+	EventTypeRequestSpendingLimit = types.EventTypeRequestSpendingLimit
+	EventTypeConfirmSpendingLimit = types.EventTypeConfirmSpendingLimit
+	AttributeValueCategory        = types.AttributeValueCategory
+	AttributeKeyOwner             = types.AttributeKeyOwner
+	AttributeKeyPeriod            = types.AttributeKeyPeriod
+	AttributeKeyConfirmableTime   = types.AttributeKeyConfirmableTime
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	RegisterCodec               = types.RegisterCodec
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	NewParams                   = types.NewParams
+	DefaultParams               = types.DefaultParams
+	ParamKeyTable               = types.ParamKeyTable
+	NewSpendingLimit            = types.NewSpendingLimit
+	NewPendingSpendingLimit     = types.NewPendingSpendingLimit
+	ValidateLimit               = types.ValidateLimit
+	NewMsgSetSpendingLimit      = types.NewMsgSetSpendingLimit
+	NewMsgConfirmSpendingLimit  = types.NewMsgConfirmSpendingLimit
+	NewQuerySpendingLimitParams = types.NewQuerySpendingLimitParams
+	GetSpendingLimitKey         = types.GetSpendingLimitKey
+	GetPendingSpendingLimitKey  = types.GetPendingSpendingLimitKey
+
+	// variable aliases
+	ModuleCdc                      = types.ModuleCdc
+	KeyOverrideDelay               = types.KeyOverrideDelay
+	SpendingLimitKeyPrefix         = types.SpendingLimitKeyPrefix
+	PendingSpendingLimitKeyPrefix  = types.PendingSpendingLimitKeyPrefix
+	ErrInvalidSpendingLimit        = types.ErrInvalidSpendingLimit
+	ErrSpendingLimitExceeded       = types.ErrSpendingLimitExceeded
+	ErrNoSpendingLimit             = types.ErrNoSpendingLimit
+	ErrNoPendingSpendingLimit      = types.ErrNoPendingSpendingLimit
+	ErrSpendingLimitNotConfirmable = types.ErrSpendingLimitNotConfirmable
+	ErrTransferLimitedDelegation   = types.ErrTransferLimitedDelegation
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	Hooks                    = keeper.Hooks
+	GenesisState             = types.GenesisState
+	Params                   = types.Params
+	SpendingLimit            = types.SpendingLimit
+	PendingSpendingLimit     = types.PendingSpendingLimit
+	MsgSetSpendingLimit      = types.MsgSetSpendingLimit
+	MsgConfirmSpendingLimit  = types.MsgConfirmSpendingLimit
+	QuerySpendingLimitParams = types.QuerySpendingLimitParams
+)
@@ -0,0 +1,36 @@
+package spendlimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// TransferDelegationDecorator rejects transfers of delegations by accounts with
+// a spending limit. Delegating is not limited, as the delegated coins return to
+// the delegator when unbonded, so a transfer of the delegation would otherwise
+// allow to send them without being accounted for.
+type TransferDelegationDecorator struct {
+	k Keeper
+}
+
+func NewTransferDelegationDecorator(k Keeper) TransferDelegationDecorator {
+	return TransferDelegationDecorator{k: k}
+}
+
+func (tdd TransferDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msg, ok := msg.(staking.MsgEditDelegation)
+		if !ok {
+			continue
+		}
+
+		if _, found := tdd.k.GetSpendingLimit(ctx, msg.DelegatorAddress); found {
+			return ctx, sdkerrors.Wrapf(
+				ErrTransferLimitedDelegation, "%s has a spending limit", msg.DelegatorAddress,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,42 @@
+package spendlimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var (
+	ownerAddr     = sdk.AccAddress([]byte("owner_______________"))
+	recipientAddr = sdk.AccAddress([]byte("recipient___________"))
+	valAddr       = sdk.ValAddress([]byte("validator___________"))
+)
+
+func TestTransferDelegationDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0).UTC()})
+
+	anteHandler := sdk.ChainAnteDecorators(spendlimit.NewTransferDelegationDecorator(app.SpendLimitKeeper))
+
+	transfer := auth.StdTx{Msgs: []sdk.Msg{staking.NewMsgEditDelegation(ownerAddr, valAddr, recipientAddr)}}
+	send := auth.StdTx{Msgs: []sdk.Msg{spendlimit.NewMsgConfirmSpendingLimit(ownerAddr)}}
+
+	_, err := anteHandler(ctx, transfer, false)
+	require.NoError(t, err)
+
+	app.SpendLimitKeeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour)
+	_, err = anteHandler(ctx, transfer, false)
+	require.True(t, spendlimit.ErrTransferLimitedDelegation.Is(err))
+
+	// other messages are not affected
+	_, err = anteHandler(ctx, send, false)
+	require.NoError(t, err)
+}
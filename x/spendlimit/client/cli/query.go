@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/types"
+)
+
+// GetQueryCmd returns the query commands for the spendlimit module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the spendlimit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQuerySpendingLimit(cdc),
+		GetCmdQueryPendingSpendingLimit(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryParams returns the command to query the spendlimit parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current spendlimit parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQuerySpendingLimit returns the command to query the spending limit of
+// an account.
+func GetCmdQuerySpendingLimit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "limit [address]",
+		Short: "Query the spending limit of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := querySpendingLimit(cliCtx, cdc, types.QuerySpendingLimit, args[0])
+			if err != nil {
+				return err
+			}
+
+			var sl types.SpendingLimit
+			if err := cdc.UnmarshalJSON(res, &sl); err != nil {
+				return fmt.Errorf("failed to unmarshal spending limit: %w", err)
+			}
+
+			return cliCtx.PrintOutput(sl)
+		},
+	}
+}
+
+// GetCmdQueryPendingSpendingLimit returns the command to query the pending
+// spending limit change of an account.
+func GetCmdQueryPendingSpendingLimit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending [address]",
+		Short: "Query the pending spending limit change of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := querySpendingLimit(cliCtx, cdc, types.QueryPendingSpendingLimit, args[0])
+			if err != nil {
+				return err
+			}
+
+			var psl types.PendingSpendingLimit
+			if err := cdc.UnmarshalJSON(res, &psl); err != nil {
+				return fmt.Errorf("failed to unmarshal pending spending limit: %w", err)
+			}
+
+			return cliCtx.PrintOutput(psl)
+		},
+	}
+}
+
+func querySpendingLimit(cliCtx context.CLIContext, cdc *codec.Codec, query, owner string) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySpendingLimitParams(addr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	return res, err
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for the spendlimit module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Spending limit transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdSetSpendingLimit(cdc),
+		GetCmdRemoveSpendingLimit(cdc),
+		GetCmdConfirmSpendingLimit(cdc),
+	)...)
+
+	return txCmd
+}
+
+// GetCmdSetSpendingLimit returns the command to set the spending limit of the
+// sender.
+func GetCmdSetSpendingLimit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set [amount] [period]",
+		Short: "Limit the amount sent to other accounts per period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Limit the amount of the given denominations sent to other accounts per period.
+A limit which is not stricter than the current one has to be confirmed once the
+override delay has passed.
+
+Example:
+$ %s tx %s set 1000stake 24h --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			period, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSpendingLimit(cliCtx.GetFromAddress(), amount, period)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRemoveSpendingLimit returns the command to remove the spending limit of
+// the sender.
+func GetCmdRemoveSpendingLimit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove",
+		Short: "Request the removal of the spending limit, to be confirmed once the override delay has passed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgSetSpendingLimit(cliCtx.GetFromAddress(), sdk.NewCoins(), 0)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdConfirmSpendingLimit returns the command to confirm the pending spending
+// limit change of the sender.
+func GetCmdConfirmSpendingLimit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "confirm",
+		Short: "Confirm the pending spending limit change once the override delay has passed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgConfirmSpendingLimit(cliCtx.GetFromAddress())
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
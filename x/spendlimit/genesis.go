@@ -0,0 +1,42 @@
+package spendlimit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the spendlimit module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, sl := range gs.SpendingLimits {
+		k.SetSpendingLimit(ctx, sl)
+	}
+
+	for _, psl := range gs.PendingSpendingLimits {
+		k.SetPendingSpendingLimit(ctx, psl)
+	}
+}
+
+// ExportGenesis returns the spendlimit module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	limits := []SpendingLimit{}
+	k.IterateSpendingLimits(ctx, func(sl SpendingLimit) bool {
+		limits = append(limits, sl)
+		return false
+	})
+
+	pending := []PendingSpendingLimit{}
+	k.IteratePendingSpendingLimits(ctx, func(psl PendingSpendingLimit) bool {
+		pending = append(pending, psl)
+		return false
+	})
+
+	return NewGenesisState(k.GetParams(ctx), limits, pending)
+}
@@ -0,0 +1,53 @@
+package spendlimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0).UTC()})
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, ownerAddr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	keeper := app.SpendLimitKeeper
+	keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, ownerAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 80))))
+
+	// the amount spent is clamped to a stricter limit
+	_, pending := keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), time.Hour)
+	require.False(t, pending)
+	sl, _ := keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), sl.Spent)
+
+	// and to a confirmed limit lowering the amount of a denomination
+	psl, pending := keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), time.Minute)
+	require.True(t, pending)
+	ctx = ctx.WithBlockTime(psl.ConfirmableTime.Add(-time.Second))
+	require.Error(t, keeper.ConfirmSpendingLimit(ctx, ownerAddr))
+	ctx = ctx.WithBlockTime(psl.ConfirmableTime)
+	require.NoError(t, keeper.ConfirmSpendingLimit(ctx, ownerAddr))
+	sl, _ = keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), sl.Spent)
+
+	_, pending = keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(), 0)
+	require.True(t, pending)
+
+	gs := spendlimit.ExportGenesis(ctx, keeper)
+	require.NoError(t, gs.Validate())
+
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, abci.Header{Height: 1, Time: ctx.BlockHeader().Time})
+	require.NotPanics(t, func() { spendlimit.InitGenesis(newCtx, newApp.SpendLimitKeeper, gs) })
+	require.Equal(t, gs, spendlimit.ExportGenesis(newCtx, newApp.SpendLimitKeeper))
+}
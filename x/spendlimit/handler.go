@@ -0,0 +1,81 @@
+package spendlimit
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for the spendlimit module's messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgSetSpendingLimit:
+			return handleMsgSetSpendingLimit(ctx, k, msg)
+
+		case MsgConfirmSpendingLimit:
+			return handleMsgConfirmSpendingLimit(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgSetSpendingLimit(ctx sdk.Context, k Keeper, msg MsgSetSpendingLimit) (*sdk.Result, error) {
+	psl, pending := k.RequestSpendingLimit(ctx, msg.Owner, msg.Amount, msg.Period)
+
+	if pending {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeRequestSpendingLimit,
+				sdk.NewAttribute(AttributeKeyOwner, msg.Owner.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(AttributeKeyPeriod, msg.Period.String()),
+				sdk.NewAttribute(AttributeKeyConfirmableTime, psl.ConfirmableTime.Format(time.RFC3339)),
+			),
+		)
+	} else {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeSetSpendingLimit,
+				sdk.NewAttribute(AttributeKeyOwner, msg.Owner.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(AttributeKeyPeriod, msg.Period.String()),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConfirmSpendingLimit(ctx sdk.Context, k Keeper, msg MsgConfirmSpendingLimit) (*sdk.Result, error) {
+	if err := k.ConfirmSpendingLimit(ctx, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeConfirmSpendingLimit,
+			sdk.NewAttribute(AttributeKeyOwner, msg.Owner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// Hooks wrapper struct for the spendlimit keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ bank.BankHooks = Hooks{}
+
+// Hooks returns the bank hooks enforcing the spending limits.
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// BeforeSend rejects transfers exceeding the spending limit of the sender.
+func (h Hooks) BeforeSend(ctx sdk.Context, fromAddr sdk.AccAddress, toAddrs []sdk.AccAddress, amt sdk.Coins) error {
+	return h.k.Spend(ctx, fromAddr, toAddrs, amt)
+}
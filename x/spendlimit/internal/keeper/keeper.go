@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/types"
+)
+
+// Keeper defines the spendlimit module's keeper. It stores the spending limits
+// of accounts and enforces them on transfers through the bank hooks.
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace params.Subspace
+
+	// recipients of transfers which are not limited, e.g. module accounts
+	exemptAddrs map[string]bool
+}
+
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, exemptAddrs map[string]bool,
+) Keeper {
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    storeKey,
+		paramSpace:  paramSpace,
+		exemptAddrs: exemptAddrs,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetSpendingLimit returns the spending limit of an account.
+func (k Keeper) GetSpendingLimit(ctx sdk.Context, owner sdk.AccAddress) (sl types.SpendingLimit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSpendingLimitKey(owner))
+	if bz == nil {
+		return sl, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &sl)
+	return sl, true
+}
+
+// SetSpendingLimit sets the spending limit of an account.
+func (k Keeper) SetSpendingLimit(ctx sdk.Context, sl types.SpendingLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSpendingLimitKey(sl.Owner), k.cdc.MustMarshalBinaryBare(sl))
+}
+
+// DeleteSpendingLimit removes the spending limit of an account.
+func (k Keeper) DeleteSpendingLimit(ctx sdk.Context, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSpendingLimitKey(owner))
+}
+
+// IterateSpendingLimits iterates over the spending limits of all accounts. If
+// true is returned from the callback, iteration is halted.
+func (k Keeper) IterateSpendingLimits(ctx sdk.Context, cb func(types.SpendingLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SpendingLimitKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sl types.SpendingLimit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sl)
+
+		if cb(sl) {
+			break
+		}
+	}
+}
+
+// GetPendingSpendingLimit returns the pending spending limit change of an
+// account.
+func (k Keeper) GetPendingSpendingLimit(
+	ctx sdk.Context, owner sdk.AccAddress,
+) (psl types.PendingSpendingLimit, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingSpendingLimitKey(owner))
+	if bz == nil {
+		return psl, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &psl)
+	return psl, true
+}
+
+// SetPendingSpendingLimit sets the pending spending limit change of an account.
+func (k Keeper) SetPendingSpendingLimit(ctx sdk.Context, psl types.PendingSpendingLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingSpendingLimitKey(psl.Owner), k.cdc.MustMarshalBinaryBare(psl))
+}
+
+// DeletePendingSpendingLimit removes the pending spending limit change of an
+// account.
+func (k Keeper) DeletePendingSpendingLimit(ctx sdk.Context, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingSpendingLimitKey(owner))
+}
+
+// IteratePendingSpendingLimits iterates over the pending spending limit changes
+// of all accounts. If true is returned from the callback, iteration is halted.
+func (k Keeper) IteratePendingSpendingLimits(ctx sdk.Context, cb func(types.PendingSpendingLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingSpendingLimitKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var psl types.PendingSpendingLimit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &psl)
+
+		if cb(psl) {
+			break
+		}
+	}
+}
+
+// RequestSpendingLimit sets the spending limit of an account to amount per
+// period, or removes it if amount is empty. A limit which is stricter than the
+// current one takes effect immediately and discards any pending change, so that
+// the owner can always revoke a change requested with a compromised key.
+// Otherwise the change is returned as pending and has to be confirmed once the
+// override delay has passed.
+func (k Keeper) RequestSpendingLimit(
+	ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coins, period time.Duration,
+) (psl types.PendingSpendingLimit, pending bool) {
+
+	sl, found := k.GetSpendingLimit(ctx, owner)
+	if found && !sl.IsStricter(amount, period) {
+		confirmableTime := ctx.BlockHeader().Time.Add(k.OverrideDelay(ctx))
+		psl = types.NewPendingSpendingLimit(owner, amount, period, confirmableTime)
+		k.SetPendingSpendingLimit(ctx, psl)
+		return psl, true
+	}
+
+	switch {
+	case found:
+		k.SetSpendingLimit(ctx, sl.WithLimit(amount, period))
+
+	case !amount.Empty():
+		k.SetSpendingLimit(ctx, types.NewSpendingLimit(owner, amount, period, ctx.BlockHeader().Time))
+	}
+
+	k.DeletePendingSpendingLimit(ctx, owner)
+	return psl, false
+}
+
+// ConfirmSpendingLimit applies the pending spending limit change of an account
+// once it is confirmable.
+func (k Keeper) ConfirmSpendingLimit(ctx sdk.Context, owner sdk.AccAddress) error {
+	psl, found := k.GetPendingSpendingLimit(ctx, owner)
+	if !found {
+		return types.ErrNoPendingSpendingLimit
+	}
+
+	if ctx.BlockHeader().Time.Before(psl.ConfirmableTime) {
+		return sdkerrors.Wrapf(types.ErrSpendingLimitNotConfirmable, "confirmable from %s", psl.ConfirmableTime)
+	}
+
+	k.DeletePendingSpendingLimit(ctx, owner)
+	if psl.Amount.Empty() {
+		k.DeleteSpendingLimit(ctx, owner)
+		return nil
+	}
+
+	sl, found := k.GetSpendingLimit(ctx, owner)
+	if !found {
+		sl = types.NewSpendingLimit(owner, psl.Amount, psl.Period, ctx.BlockHeader().Time)
+	}
+
+	k.SetSpendingLimit(ctx, sl.WithLimit(psl.Amount, psl.Period))
+
+	return nil
+}
+
+// Spend accounts for amt being sent by an account to the given recipients,
+// returning an error if it exceeds the spending limit of the account. Nothing is
+// accounted for if all recipients are exempt.
+func (k Keeper) Spend(ctx sdk.Context, owner sdk.AccAddress, toAddrs []sdk.AccAddress, amt sdk.Coins) error {
+	if k.isExempt(toAddrs) {
+		return nil
+	}
+
+	sl, found := k.GetSpendingLimit(ctx, owner)
+	if !found {
+		return nil
+	}
+
+	sl, err := sl.Spend(amt, ctx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+
+	k.SetSpendingLimit(ctx, sl)
+	return nil
+}
+
+func (k Keeper) isExempt(addrs []sdk.AccAddress) bool {
+	for _, addr := range addrs {
+		if !k.exemptAddrs[addr.String()] {
+			return false
+		}
+	}
+
+	return len(addrs) > 0
+}
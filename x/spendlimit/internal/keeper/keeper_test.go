@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/types"
+)
+
+var (
+	ownerAddr     = sdk.AccAddress([]byte("owner_______________"))
+	recipientAddr = sdk.AccAddress([]byte("recipient___________"))
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0).UTC()})
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, ownerAddr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	err := app.BankKeeper.SetBalances(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 1000)))
+	if err != nil {
+		panic(err)
+	}
+
+	return app, ctx
+}
+
+func TestRequestSpendingLimit(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.SpendLimitKeeper
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// a first limit takes effect immediately
+	_, pending := keeper.RequestSpendingLimit(ctx, ownerAddr, limit, time.Hour)
+	require.False(t, pending)
+	sl, found := keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.True(t, found)
+	require.Equal(t, limit, sl.Amount)
+	require.Equal(t, time.Hour, sl.Period)
+	require.Equal(t, ctx.BlockHeader().Time, sl.PeriodStart)
+	require.True(t, sl.Spent.Empty())
+
+	// a looser limit is pending until the override delay has passed
+	looser := sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+	psl, pending := keeper.RequestSpendingLimit(ctx, ownerAddr, looser, time.Hour)
+	require.True(t, pending)
+	require.Equal(t, ctx.BlockHeader().Time.Add(types.DefaultOverrideDelay), psl.ConfirmableTime)
+	require.True(t, types.ErrSpendingLimitNotConfirmable.Is(keeper.ConfirmSpendingLimit(ctx, ownerAddr)))
+
+	ctx = ctx.WithBlockTime(psl.ConfirmableTime)
+	require.NoError(t, keeper.ConfirmSpendingLimit(ctx, ownerAddr))
+	sl, found = keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.True(t, found)
+	require.Equal(t, looser, sl.Amount)
+	require.True(t, types.ErrNoPendingSpendingLimit.Is(keeper.ConfirmSpendingLimit(ctx, ownerAddr)))
+
+	// a stricter limit discards the pending removal
+	_, pending = keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(), 0)
+	require.True(t, pending)
+	_, pending = keeper.RequestSpendingLimit(ctx, ownerAddr, limit, 2*time.Hour)
+	require.False(t, pending)
+	_, found = keeper.GetPendingSpendingLimit(ctx, ownerAddr)
+	require.False(t, found)
+	sl, _ = keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.Equal(t, limit, sl.Amount)
+	require.Equal(t, 2*time.Hour, sl.Period)
+
+	// the removal is applied once confirmed
+	psl, pending = keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(), 0)
+	require.True(t, pending)
+	require.NoError(t, keeper.ConfirmSpendingLimit(ctx.WithBlockTime(psl.ConfirmableTime), ownerAddr))
+	_, found = keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.False(t, found)
+}
+
+func TestSpendingLimitEnforced(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.SpendLimitKeeper
+	keeper.RequestSpendingLimit(ctx, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour)
+
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, ownerAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	err := app.BankKeeper.SendCoins(ctx, ownerAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	require.True(t, types.ErrSpendingLimitExceeded.Is(err))
+
+	// other denominations are not limited
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, ownerAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 500))))
+
+	// multi sends are limited as well
+	inputs := []bank.Input{bank.NewInput(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))}
+	outputs := []bank.Output{bank.NewOutput(recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))}
+	require.True(t, types.ErrSpendingLimitExceeded.Is(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)))
+
+	// sending to module accounts is exempt
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, ownerAddr, feeCollector, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	// the amount spent is reset with a new period
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, ownerAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+
+	sl, _ := keeper.GetSpendingLimit(ctx, ownerAddr)
+	require.Equal(t, ctx.BlockHeader().Time, sl.PeriodStart)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), sl.Spent)
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/spendlimit/internal/types"
+)
+
+// OverrideDelay returns the time after which a change loosening a spending
+// limit can be confirmed.
+func (k Keeper) OverrideDelay(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyOverrideDelay, &res)
+	return
+}
+
+// GetParams returns the total set of spendlimit parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the spendlimit parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the spendlimit module's codec.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// spendlimit module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSetSpendingLimit{}, "cosmos-sdk/MsgSetSpendingLimit", nil)
+	cdc.RegisterConcrete(MsgConfirmSpendingLimit{}, "cosmos-sdk/MsgConfirmSpendingLimit", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
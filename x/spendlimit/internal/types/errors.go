@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/spendlimit module sentinel errors
+var (
+	ErrInvalidSpendingLimit        = sdkerrors.Register(ModuleName, 1, "invalid spending limit")
+	ErrSpendingLimitExceeded       = sdkerrors.Register(ModuleName, 2, "spending limit exceeded")
+	ErrNoSpendingLimit             = sdkerrors.Register(ModuleName, 3, "no spending limit set")
+	ErrNoPendingSpendingLimit      = sdkerrors.Register(ModuleName, 4, "no pending spending limit change")
+	ErrSpendingLimitNotConfirmable = sdkerrors.Register(ModuleName, 5, "pending spending limit change not yet confirmable")
+	ErrTransferLimitedDelegation   = sdkerrors.Register(ModuleName, 6, "cannot transfer delegation of account with spending limit")
+)
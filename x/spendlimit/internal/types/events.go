@@ -0,0 +1,13 @@
+package types
+
+// spendlimit module events
+const (
+	EventTypeSetSpendingLimit     = "set_spending_limit"
+	EventTypeRequestSpendingLimit = "request_spending_limit"
+	EventTypeConfirmSpendingLimit = "confirm_spending_limit"
+
+	AttributeValueCategory      = ModuleName
+	AttributeKeyOwner           = "owner"
+	AttributeKeyPeriod          = "period"
+	AttributeKeyConfirmableTime = "confirmable_time"
+)
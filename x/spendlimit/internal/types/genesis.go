@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the spendlimit module's genesis state.
+type GenesisState struct {
+	Params                Params                 `json:"params" yaml:"params"`
+	SpendingLimits        []SpendingLimit        `json:"spending_limits" yaml:"spending_limits"`
+	PendingSpendingLimits []PendingSpendingLimit `json:"pending_spending_limits" yaml:"pending_spending_limits"`
+}
+
+func NewGenesisState(p Params, limits []SpendingLimit, pending []PendingSpendingLimit) GenesisState {
+	return GenesisState{
+		Params:                p,
+		SpendingLimits:        limits,
+		PendingSpendingLimits: pending,
+	}
+}
+
+// DefaultGenesisState returns the spendlimit module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []SpendingLimit{}, []PendingSpendingLimit{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	owners := make(map[string]bool, len(gs.SpendingLimits))
+	for _, sl := range gs.SpendingLimits {
+		if err := sl.Validate(); err != nil {
+			return err
+		}
+		if owners[sl.Owner.String()] {
+			return fmt.Errorf("duplicate spending limit of %s", sl.Owner)
+		}
+		owners[sl.Owner.String()] = true
+	}
+
+	owners = make(map[string]bool, len(gs.PendingSpendingLimits))
+	for _, psl := range gs.PendingSpendingLimits {
+		if err := psl.Validate(); err != nil {
+			return err
+		}
+		if owners[psl.Owner.String()] {
+			return fmt.Errorf("duplicate pending spending limit of %s", psl.Owner)
+		}
+		owners[psl.Owner.String()] = true
+	}
+
+	return nil
+}
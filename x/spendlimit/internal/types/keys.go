@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "spendlimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	SpendingLimitKeyPrefix        = []byte{0x01}
+	PendingSpendingLimitKeyPrefix = []byte{0x02}
+)
+
+// GetSpendingLimitKey returns the key of the spending limit of an account.
+func GetSpendingLimitKey(owner sdk.AccAddress) []byte {
+	return append(SpendingLimitKeyPrefix, owner.Bytes()...)
+}
+
+// GetPendingSpendingLimitKey returns the key of the pending spending limit
+// change of an account.
+func GetPendingSpendingLimitKey(owner sdk.AccAddress) []byte {
+	return append(PendingSpendingLimitKeyPrefix, owner.Bytes()...)
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Message types for the spendlimit module
+const (
+	TypeMsgSetSpendingLimit     = "set_spending_limit"
+	TypeMsgConfirmSpendingLimit = "confirm_spending_limit"
+)
+
+var (
+	_ sdk.Msg = MsgSetSpendingLimit{}
+	_ sdk.Msg = MsgConfirmSpendingLimit{}
+)
+
+// MsgSetSpendingLimit defines an sdk.Msg type that sets the spending limit of
+// the owner to amount per period, or removes it if amount is empty. A change
+// which is not stricter than the current limit has to be confirmed with a
+// MsgConfirmSpendingLimit once the override delay has passed.
+type MsgSetSpendingLimit struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+	Period time.Duration  `json:"period" yaml:"period"`
+}
+
+func NewMsgSetSpendingLimit(owner sdk.AccAddress, amount sdk.Coins, period time.Duration) MsgSetSpendingLimit {
+	return MsgSetSpendingLimit{Owner: owner, Amount: amount, Period: period}
+}
+
+// Route returns the MsgSetSpendingLimit's route.
+func (m MsgSetSpendingLimit) Route() string { return RouterKey }
+
+// Type returns the MsgSetSpendingLimit's type.
+func (m MsgSetSpendingLimit) Type() string { return TypeMsgSetSpendingLimit }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgSetSpendingLimit.
+func (m MsgSetSpendingLimit) ValidateBasic() error {
+	if m.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Owner.String())
+	}
+	if m.Amount.Empty() {
+		return nil
+	}
+
+	return ValidateLimit(m.Amount, m.Period)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgSetSpendingLimit message.
+func (m MsgSetSpendingLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the single expected signer for a MsgSetSpendingLimit.
+func (m MsgSetSpendingLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Owner}
+}
+
+// MsgConfirmSpendingLimit defines an sdk.Msg type that confirms the pending
+// spending limit change of the owner.
+type MsgConfirmSpendingLimit struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewMsgConfirmSpendingLimit(owner sdk.AccAddress) MsgConfirmSpendingLimit {
+	return MsgConfirmSpendingLimit{Owner: owner}
+}
+
+// Route returns the MsgConfirmSpendingLimit's route.
+func (m MsgConfirmSpendingLimit) Route() string { return RouterKey }
+
+// Type returns the MsgConfirmSpendingLimit's type.
+func (m MsgConfirmSpendingLimit) Type() string { return TypeMsgConfirmSpendingLimit }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgConfirmSpendingLimit.
+func (m MsgConfirmSpendingLimit) ValidateBasic() error {
+	if m.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Owner.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgConfirmSpendingLimit message.
+func (m MsgConfirmSpendingLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the single expected signer for a MsgConfirmSpendingLimit.
+func (m MsgConfirmSpendingLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Owner}
+}
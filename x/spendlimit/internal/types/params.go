@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameter values
+const (
+	DefaultParamspace    = ModuleName
+	DefaultOverrideDelay = 48 * time.Hour
+)
+
+// Parameter store keys
+var (
+	KeyOverrideDelay = []byte("OverrideDelay")
+)
+
+// Params defines the total set of parameters for the spendlimit module
+type Params struct {
+	// OverrideDelay is the time after which a change loosening the spending
+	// limit of an account can be confirmed.
+	OverrideDelay time.Duration `json:"override_delay" yaml:"override_delay"`
+}
+
+// NewParams creates a new Params object
+func NewParams(overrideDelay time.Duration) Params {
+	return Params{
+		OverrideDelay: overrideDelay,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyOverrideDelay, &p.OverrideDelay, validateOverrideDelay),
+	}
+}
+
+// DefaultParams returns the default parameters for the spendlimit module.
+func DefaultParams() Params {
+	return NewParams(DefaultOverrideDelay)
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	return validateOverrideDelay(p.OverrideDelay)
+}
+
+func validateOverrideDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("override delay must be positive: %s", v)
+	}
+
+	return nil
+}
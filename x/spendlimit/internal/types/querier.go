@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the spendlimit module
+const (
+	QueryParameters           = "parameters"
+	QuerySpendingLimit        = "spending_limit"
+	QueryPendingSpendingLimit = "pending_spending_limit"
+)
+
+// QuerySpendingLimitParams defines the parameters necessary for querying the
+// spending limit or the pending spending limit change of an account.
+type QuerySpendingLimitParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+func NewQuerySpendingLimitParams(owner sdk.AccAddress) QuerySpendingLimitParams {
+	return QuerySpendingLimitParams{Owner: owner}
+}
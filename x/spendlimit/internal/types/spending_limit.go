@@ -0,0 +1,181 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SpendingLimit defines the maximum amount an account can send to other
+// accounts per period. Only the denominations of the limit are limited. Spent
+// is the amount sent since the start of the current period.
+type SpendingLimit struct {
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Period      time.Duration  `json:"period" yaml:"period"`
+	PeriodStart time.Time      `json:"period_start" yaml:"period_start"`
+	Spent       sdk.Coins      `json:"spent" yaml:"spent"`
+}
+
+// NewSpendingLimit creates a new SpendingLimit object whose first period
+// starts at the given time.
+func NewSpendingLimit(owner sdk.AccAddress, amount sdk.Coins, period time.Duration, start time.Time) SpendingLimit {
+	return SpendingLimit{
+		Owner:       owner,
+		Amount:      amount,
+		Period:      period,
+		PeriodStart: start,
+		Spent:       sdk.NewCoins(),
+	}
+}
+
+// Limited returns the part of the coins whose denominations are limited.
+func (sl SpendingLimit) Limited(coins sdk.Coins) sdk.Coins {
+	limited := sdk.NewCoins()
+	for _, coin := range coins {
+		if sl.Amount.AmountOf(coin.Denom).IsPositive() {
+			limited = limited.Add(coin)
+		}
+	}
+
+	return limited
+}
+
+// WithLimit returns the spending limit with the given amount per period. The
+// amount spent during the current period stays accounted for, up to the new
+// limit of each denomination.
+func (sl SpendingLimit) WithLimit(amount sdk.Coins, period time.Duration) SpendingLimit {
+	sl.Amount, sl.Period = amount, period
+
+	spent := sdk.NewCoins()
+	for _, coin := range sl.Spent {
+		limit := amount.AmountOf(coin.Denom)
+		if !limit.IsPositive() {
+			continue
+		}
+		if coin.Amount.GT(limit) {
+			coin.Amount = limit
+		}
+		spent = spent.Add(coin)
+	}
+
+	sl.Spent = spent
+	return sl
+}
+
+// Spend returns the spending limit after sending amt at the given time,
+// starting a new period if the current one has ended. An error is returned if
+// the amount sent during the period would exceed the limit.
+func (sl SpendingLimit) Spend(amt sdk.Coins, now time.Time) (SpendingLimit, error) {
+	if !now.Before(sl.PeriodStart.Add(sl.Period)) {
+		sl.PeriodStart = now
+		sl.Spent = sdk.NewCoins()
+	}
+
+	spent := sl.Spent.Add(sl.Limited(amt)...)
+	for _, coin := range spent {
+		if coin.Amount.GT(sl.Amount.AmountOf(coin.Denom)) {
+			return sl, sdkerrors.Wrapf(
+				ErrSpendingLimitExceeded, "cannot send %s; spent %s of %s since %s", amt, sl.Spent, sl.Amount, sl.PeriodStart,
+			)
+		}
+	}
+
+	sl.Spent = spent
+	return sl, nil
+}
+
+// IsStricter returns whether a limit of amount per period allows to send no
+// more than the spending limit, i.e. if every limited denomination stays limited
+// to at most the same amount per at least the same period.
+func (sl SpendingLimit) IsStricter(amount sdk.Coins, period time.Duration) bool {
+	if amount.Empty() || period < sl.Period {
+		return false
+	}
+
+	for _, coin := range sl.Amount {
+		limit := amount.AmountOf(coin.Denom)
+		if !limit.IsPositive() || limit.GT(coin.Amount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate performs basic validation of the spending limit.
+func (sl SpendingLimit) Validate() error {
+	if sl.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner")
+	}
+	if err := ValidateLimit(sl.Amount, sl.Period); err != nil {
+		return err
+	}
+	if !sl.Spent.IsValid() || !sl.Spent.IsAllLTE(sl.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidSpendingLimit, "invalid spent amount %s of %s", sl.Spent, sl.Amount)
+	}
+
+	return nil
+}
+
+func (sl SpendingLimit) String() string {
+	out, _ := yaml.Marshal(sl)
+	return string(out)
+}
+
+// PendingSpendingLimit defines a change of the spending limit of an account
+// which does not take effect until it is confirmed, as it would allow to send
+// more. It can be confirmed from the confirmable time on. An empty amount
+// removes the spending limit.
+type PendingSpendingLimit struct {
+	Owner           sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount          sdk.Coins      `json:"amount" yaml:"amount"`
+	Period          time.Duration  `json:"period" yaml:"period"`
+	ConfirmableTime time.Time      `json:"confirmable_time" yaml:"confirmable_time"`
+}
+
+// NewPendingSpendingLimit creates a new PendingSpendingLimit object.
+func NewPendingSpendingLimit(
+	owner sdk.AccAddress, amount sdk.Coins, period time.Duration, confirmableTime time.Time,
+) PendingSpendingLimit {
+
+	return PendingSpendingLimit{
+		Owner:           owner,
+		Amount:          amount,
+		Period:          period,
+		ConfirmableTime: confirmableTime,
+	}
+}
+
+// Validate performs basic validation of the pending spending limit change.
+func (psl PendingSpendingLimit) Validate() error {
+	if psl.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner")
+	}
+	if psl.Amount.Empty() {
+		return nil
+	}
+
+	return ValidateLimit(psl.Amount, psl.Period)
+}
+
+func (psl PendingSpendingLimit) String() string {
+	out, _ := yaml.Marshal(psl)
+	return string(out)
+}
+
+// ValidateLimit validates a limit of amount per period.
+func ValidateLimit(amount sdk.Coins, period time.Duration) error {
+	if amount.Empty() || !amount.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidSpendingLimit, "invalid amount %s", amount)
+	}
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidSpendingLimit, fmt.Sprintf("period must be positive: %s", period))
+	}
+
+	return nil
+}
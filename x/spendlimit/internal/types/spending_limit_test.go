@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSpendingLimitSpend(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	sl := NewSpendingLimit(owner, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, start)
+	require.NoError(t, sl.Validate())
+
+	sl, err := sl.Spend(sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("atom", 500)), start)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), sl.Spent)
+
+	_, err = sl.Spend(sdk.NewCoins(sdk.NewInt64Coin("stake", 41)), start.Add(time.Minute))
+	require.True(t, ErrSpendingLimitExceeded.Is(err))
+
+	// a new period starts once the current one has ended
+	sl, err = sl.Spend(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, start.Add(time.Hour), sl.PeriodStart)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sl.Spent)
+}
+
+func TestSpendingLimitIsStricter(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	sl := NewSpendingLimit(owner, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, time.Time{})
+
+	testCases := []struct {
+		name     string
+		amount   sdk.Coins
+		period   time.Duration
+		expected bool
+	}{
+		{"same limit", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, true},
+		{"lower amount", sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), time.Hour, true},
+		{"longer period", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 2 * time.Hour, true},
+		{"additional denom", sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 100)), time.Hour, true},
+		{"higher amount", sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), time.Hour, false},
+		{"shorter period", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Minute, false},
+		{"other denom", sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), time.Hour, false},
+		{"removal", sdk.NewCoins(), 0, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, sl.IsStricter(tc.amount, tc.period), tc.name)
+	}
+}
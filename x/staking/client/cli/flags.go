@@ -28,6 +28,8 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagPreview = "preview"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
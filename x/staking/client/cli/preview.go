@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TxPreview contains the expected outcome of a delegate, unbond or redelegate
+// transaction. It is computed from the current validator exchange rates and
+// is therefore only an estimate, since the rates may change before the
+// transaction is included in a block.
+type TxPreview struct {
+	Validator         sdk.ValAddress `json:"validator" yaml:"validator"`
+	Shares            sdk.Dec        `json:"shares" yaml:"shares"`
+	DelegationBalance sdk.Int        `json:"delegation_balance" yaml:"delegation_balance"`
+
+	// only set for redelegations
+	DstValidator         sdk.ValAddress `json:"dst_validator,omitempty" yaml:"dst_validator,omitempty"`
+	DstShares            sdk.Dec        `json:"dst_shares,omitempty" yaml:"dst_shares,omitempty"`
+	DstDelegationBalance sdk.Int        `json:"dst_delegation_balance,omitempty" yaml:"dst_delegation_balance,omitempty"`
+
+	// only set for delegations
+	AccountBalance sdk.Coin `json:"account_balance,omitempty" yaml:"account_balance,omitempty"`
+
+	// only set for unbondings and redelegations, zero if they complete immediately
+	CompletionTime time.Time `json:"completion_time,omitempty" yaml:"completion_time,omitempty"`
+}
+
+// String implements the Stringer interface for TxPreview.
+func (p TxPreview) String() string {
+	var b strings.Builder
+
+	b.WriteString("Transaction preview:\n")
+	fmt.Fprintf(&b, "  Validator:                      %s\n", p.Validator)
+	fmt.Fprintf(&b, "  Shares:                         %s\n", p.Shares)
+	fmt.Fprintf(&b, "  Resulting Delegation Balance:   %s\n", p.DelegationBalance)
+
+	if !p.DstValidator.Empty() {
+		fmt.Fprintf(&b, "  Destination Validator:          %s\n", p.DstValidator)
+		fmt.Fprintf(&b, "  Destination Shares:             %s\n", p.DstShares)
+		fmt.Fprintf(&b, "  Resulting Destination Balance:  %s\n", p.DstDelegationBalance)
+	}
+
+	if p.AccountBalance.Denom != "" {
+		fmt.Fprintf(&b, "  Resulting Account Balance:      %s\n", p.AccountBalance)
+	}
+
+	if !p.CompletionTime.IsZero() {
+		fmt.Fprintf(&b, "  Estimated Completion Time:      %s\n", p.CompletionTime.Format(time.RFC3339))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// PreviewDelegate returns the expected outcome of delegating amount from the
+// delegator to the validator.
+func PreviewDelegate(
+	cliCtx context.CLIContext, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin,
+) (TxPreview, error) {
+
+	validator, err := queryValidator(cliCtx, valAddr)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	balance, err := queryDelegationBalance(cliCtx, delAddr, valAddr)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	accBalance, err := queryAccountBalance(cliCtx, delAddr, amount.Denom)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	if accBalance.IsLT(amount) {
+		return TxPreview{}, fmt.Errorf("insufficient account balance: %s < %s", accBalance, amount)
+	}
+
+	_, shares := validator.AddTokensFromDel(amount.Amount)
+
+	return TxPreview{
+		Validator:         valAddr,
+		Shares:            shares,
+		DelegationBalance: balance.Add(amount.Amount),
+		AccountBalance:    accBalance.Sub(amount),
+	}, nil
+}
+
+// PreviewUnbond returns the expected outcome of unbonding amount from the
+// delegation between the delegator and the validator.
+func PreviewUnbond(
+	cliCtx context.CLIContext, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin,
+) (TxPreview, error) {
+
+	validator, shares, balance, err := previewSharesFromTokens(cliCtx, delAddr, valAddr, amount)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	completionTime, err := queryCompletionTime(cliCtx, validator)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	return TxPreview{
+		Validator:         valAddr,
+		Shares:            shares,
+		DelegationBalance: balance.Sub(amount.Amount),
+		CompletionTime:    completionTime,
+	}, nil
+}
+
+// PreviewRedelegate returns the expected outcome of redelegating amount from
+// the source to the destination validator.
+func PreviewRedelegate(
+	cliCtx context.CLIContext, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Coin,
+) (TxPreview, error) {
+
+	srcValidator, shares, balance, err := previewSharesFromTokens(cliCtx, delAddr, valSrcAddr, amount)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	dstValidator, err := queryValidator(cliCtx, valDstAddr)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	dstBalance, err := queryDelegationBalance(cliCtx, delAddr, valDstAddr)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	completionTime, err := queryCompletionTime(cliCtx, srcValidator)
+	if err != nil {
+		return TxPreview{}, err
+	}
+
+	_, dstShares := dstValidator.AddTokensFromDel(amount.Amount)
+
+	return TxPreview{
+		Validator:            valSrcAddr,
+		Shares:               shares,
+		DelegationBalance:    balance.Sub(amount.Amount),
+		DstValidator:         valDstAddr,
+		DstShares:            dstShares,
+		DstDelegationBalance: dstBalance.Add(amount.Amount),
+		CompletionTime:       completionTime,
+	}, nil
+}
+
+// previewSharesFromTokens returns the validator, the shares that would be
+// removed from the delegation for the given amount and the current balance of
+// the delegation. It returns an error if the delegation is too small.
+func previewSharesFromTokens(
+	cliCtx context.CLIContext, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin,
+) (types.Validator, sdk.Dec, sdk.Int, error) {
+
+	validator, err := queryValidator(cliCtx, valAddr)
+	if err != nil {
+		return types.Validator{}, sdk.Dec{}, sdk.Int{}, err
+	}
+
+	balance, err := queryDelegationBalance(cliCtx, delAddr, valAddr)
+	if err != nil {
+		return types.Validator{}, sdk.Dec{}, sdk.Int{}, err
+	}
+
+	if balance.LT(amount.Amount) {
+		return types.Validator{}, sdk.Dec{}, sdk.Int{}, fmt.Errorf(
+			"insufficient delegation balance: %s < %s", balance, amount.Amount,
+		)
+	}
+
+	shares, err := validator.SharesFromTokens(amount.Amount)
+	if err != nil {
+		return types.Validator{}, sdk.Dec{}, sdk.Int{}, err
+	}
+
+	return validator, shares, balance, nil
+}
+
+func queryValidator(cliCtx context.CLIContext, valAddr sdk.ValAddress) (types.Validator, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidator)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	var validator types.Validator
+	if err := cliCtx.Codec.UnmarshalJSON(res, &validator); err != nil {
+		return types.Validator{}, err
+	}
+
+	return validator, nil
+}
+
+// queryDelegationBalance returns the token balance of the delegation between
+// the delegator and the validator, or zero if it does not exist.
+func queryDelegationBalance(cliCtx context.CLIContext, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Int, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	var delegations types.DelegationResponses
+	if err := cliCtx.Codec.UnmarshalJSON(res, &delegations); err != nil {
+		return sdk.Int{}, err
+	}
+
+	for _, del := range delegations {
+		if del.ValidatorAddress.Equals(valAddr) {
+			return del.Balance.Amount, nil
+		}
+	}
+
+	return sdk.ZeroInt(), nil
+}
+
+func queryAccountBalance(cliCtx context.CLIContext, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(bank.NewQueryBalanceParams(addr, denom))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QueryBalance)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	var balance sdk.Coin
+	if err := cliCtx.Codec.UnmarshalJSON(res, &balance); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return balance, nil
+}
+
+// queryCompletionTime estimates when an unbonding or redelegation from the
+// given validator completes. It mirrors the keeper logic, using the local
+// clock in place of the block time.
+func queryCompletionTime(cliCtx context.CLIContext, validator types.Validator) (time.Time, error) {
+	switch {
+	case validator.IsUnbonded():
+		return time.Time{}, nil
+
+	case validator.IsUnbonding():
+		return validator.UnbondingTime, nil
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+	res, _, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var params types.Params
+	if err := cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Now().UTC().Add(params.UnbondingTime), nil
+}
@@ -30,8 +30,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryDelegations(queryRoute, cdc),
 		GetCmdQueryUnbondingDelegation(queryRoute, cdc),
 		GetCmdQueryUnbondingDelegations(queryRoute, cdc),
+		GetCmdQueryTotalStake(queryRoute, cdc),
+		GetCmdQueryMaxDelegatable(queryRoute, cdc),
 		GetCmdQueryRedelegation(queryRoute, cdc),
 		GetCmdQueryRedelegations(queryRoute, cdc),
+		GetCmdQueryRedelegationBudget(queryRoute, cdc),
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
 		GetCmdQueryValidatorDelegations(queryRoute, cdc),
@@ -51,7 +54,8 @@ func GetCmdQueryValidator(storeName string, cdc *codec.Codec) *cobra.Command {
 		Use:   "validator [validator-addr]",
 		Short: "Query a validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query details about an individual validator.
+			fmt.Sprintf(`Query details about an individual validator. The validator may be given by
+either its operator address or the account address of its operator.
 
 Example:
 $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
@@ -63,7 +67,7 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			addr, err := sdk.ValAddressFromBech32(args[0])
+			addr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -131,7 +135,8 @@ func GetCmdQueryValidatorUnbondingDelegations(queryRoute string, cdc *codec.Code
 		Use:   "unbonding-delegations-from [validator-addr]",
 		Short: "Query all unbonding delegatations from a validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query delegations that are unbonding _from_ a validator.
+			fmt.Sprintf(`Query delegations that are unbonding _from_ a validator. The validator may be given by
+either its operator address or the account address of its operator.
 
 Example:
 $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
@@ -143,7 +148,7 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -159,7 +164,7 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 				return err
 			}
 
-			var ubds types.UnbondingDelegations
+			var ubds types.UnbondingDelegationResponses
 			cdc.MustUnmarshalJSON(res, &ubds)
 			return cliCtx.PrintOutput(ubds)
 		},
@@ -173,7 +178,8 @@ func GetCmdQueryValidatorRedelegations(queryRoute string, cdc *codec.Codec) *cob
 		Use:   "redelegations-from [validator-addr]",
 		Short: "Query all outgoing redelegatations from a validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query delegations that are redelegating _from_ a validator.
+			fmt.Sprintf(`Query delegations that are redelegating _from_ a validator. The validator may be given by
+either its operator address or the account address of its operator.
 
 Example:
 $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
@@ -185,7 +191,7 @@ $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			valSrcAddr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -234,7 +240,7 @@ $ %s query staking delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosm
 				return err
 			}
 
-			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			valAddr, err := sdk.ValAddressFromAccOrValBech32(args[1])
 			if err != nil {
 				return err
 			}
@@ -312,7 +318,8 @@ func GetCmdQueryValidatorDelegations(queryRoute string, cdc *codec.Codec) *cobra
 		Use:   "delegations-to [validator-addr]",
 		Short: "Query all delegations made to one validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query delegations on an individual validator.
+			fmt.Sprintf(`Query delegations on an individual validator. The validator may be given by
+either its operator address or the account address of its operator.
 
 Example:
 $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
@@ -324,7 +331,7 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := sdk.ValAddressFromAccOrValBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -369,7 +376,7 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			valAddr, err := sdk.ValAddressFromAccOrValBech32(args[1])
 			if err != nil {
 				return err
 			}
@@ -390,8 +397,8 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			ubd, err := types.UnmarshalUBD(types.ModuleCdc, res)
-			if err != nil {
+			var ubd types.UnbondingDelegationResponse
+			if err = cdc.UnmarshalJSON(res, &ubd); err != nil {
 				return err
 			}
 
@@ -435,7 +442,7 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			var ubds types.UnbondingDelegations
+			var ubds types.UnbondingDelegationResponses
 			if err = cdc.UnmarshalJSON(res, &ubds); err != nil {
 				return err
 			}
@@ -445,6 +452,98 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 	}
 }
 
+// GetCmdQueryTotalStake implements the command to query the aggregated stake
+// of a delegator.
+func GetCmdQueryTotalStake(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "total-stake [delegator-addr]",
+		Short: "Query the total bonded and unbonding stake of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total delegated, bonded and unbonding stake of an individual
+delegator along with a breakdown per validator.
+
+Example:
+$ %s query staking total-stake cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorTotalStake)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var stake types.DelegatorStakeResponse
+			if err = cdc.UnmarshalJSON(res, &stake); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(stake)
+		},
+	}
+}
+
+// GetCmdQueryMaxDelegatable implements the command to query the maximum amount
+// a delegator may delegate.
+func GetCmdQueryMaxDelegatable(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "max-delegatable [delegator-addr]",
+		Short: "Query the maximum amount one delegator may delegate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the maximum amount of the bond denom an individual delegator may
+delegate. For vesting accounts this includes the coins that are still vesting.
+
+Example:
+$ %s query staking max-delegatable cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorMaxDelegatable)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var delegatable sdk.Coin
+			if err = cdc.UnmarshalJSON(res, &delegatable); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(delegatable)
+		},
+	}
+}
+
 // GetCmdQueryRedelegation implements the command to query a single
 // redelegation record.
 func GetCmdQueryRedelegation(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -460,7 +559,7 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(3),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -469,17 +568,12 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 				return err
 			}
 
-			valSrcAddr, err := sdk.ValAddressFromBech32(args[1])
+			valSrcAddr, err := sdk.ValAddressFromAccOrValBech32(args[1])
 			if err != nil {
 				return err
 			}
 
-			valDstAddr, err := sdk.ValAddressFromBech32(args[2])
-			if err != nil {
-				return err
-			}
-
-			bz, err := cdc.MarshalJSON(types.NewQueryRedelegationParams(delAddr, valSrcAddr, valDstAddr))
+			bz, err := cdc.MarshalJSON(types.NewQueryBondsParams(delAddr, valSrcAddr))
 			if err != nil {
 				return err
 			}
@@ -500,6 +594,59 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 	}
 }
 
+// GetCmdQueryRedelegationBudget implements the command to query the remaining
+// shares a delegator may redelegate from a validator.
+func GetCmdQueryRedelegationBudget(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegation-budget [delegator-addr] [src-validator-addr]",
+		Short: "Query the remaining shares a delegator may redelegate from a source validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the destination shares an individual delegator may still redelegate
+from a source validator, to any destination validators, before the max
+redelegation shares per validator parameter is reached. If the parameter is not
+set, redelegations are not limited.
+
+Example:
+$ %s query staking redelegation-budget cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valSrcAddr, err := sdk.ValAddressFromAccOrValBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryBondsParams(delAddr, valSrcAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRedelegationBudget)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var budget types.RedelegationBudget
+			if err := cdc.UnmarshalJSON(res, &budget); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(budget)
+		},
+	}
+}
+
 // GetCmdQueryRedelegations implements the command to query all the
 // redelegation records for a delegator.
 func GetCmdQueryRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -40,6 +40,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdEditDelegation(cdc),
 	)...)
 
 	return stakingTxCmd
@@ -141,7 +142,7 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdDelegate implements the delegate command.
 func GetCmdDelegate(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegate [validator-addr] [amount]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Delegate liquid tokens to a validator",
@@ -170,15 +171,28 @@ $ %s tx staking delegate cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 10
 				return err
 			}
 
+			if viper.GetBool(FlagPreview) {
+				preview, err := PreviewDelegate(cliCtx, delAddr, valAddr, amount)
+				if err != nil {
+					return err
+				}
+
+				_, _ = fmt.Fprintf(os.Stderr, "%s\n", preview.String())
+			}
+
 			msg := types.NewMsgDelegate(delAddr, valAddr, amount)
 			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Bool(FlagPreview, false, "Print the expected shares, completion time and resulting balances before broadcasting")
+
+	return cmd
 }
 
 // GetCmdRedelegate the begin redelegation command.
 func GetCmdRedelegate(storeName string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
 		Short: "Redelegate illiquid tokens from one validator to another",
 		Args:  cobra.ExactArgs(3),
@@ -212,15 +226,28 @@ $ %s tx staking redelegate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
+			if viper.GetBool(FlagPreview) {
+				preview, err := PreviewRedelegate(cliCtx, delAddr, valSrcAddr, valDstAddr, amount)
+				if err != nil {
+					return err
+				}
+
+				_, _ = fmt.Fprintf(os.Stderr, "%s\n", preview.String())
+			}
+
 			msg := types.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, amount)
 			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Bool(FlagPreview, false, "Print the expected shares, completion time and resulting balances before broadcasting")
+
+	return cmd
 }
 
 // GetCmdUnbond implements the unbond validator command.
 func GetCmdUnbond(storeName string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbond [validator-addr] [amount]",
 		Short: "Unbond shares from a validator",
 		Args:  cobra.ExactArgs(2),
@@ -249,10 +276,67 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 				return err
 			}
 
+			if viper.GetBool(FlagPreview) {
+				preview, err := PreviewUnbond(cliCtx, delAddr, valAddr, amount)
+				if err != nil {
+					return err
+				}
+
+				_, _ = fmt.Fprintf(os.Stderr, "%s\n", preview.String())
+			}
+
 			msg := types.NewMsgUndelegate(delAddr, valAddr, amount)
 			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Bool(FlagPreview, false, "Print the expected shares, completion time and resulting balances before broadcasting")
+
+	return cmd
+}
+
+// GetCmdEditDelegation implements the command to transfer a delegation to a
+// new delegator.
+func GetCmdEditDelegation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit-delegation [validator-addr] [new-delegator-addr]",
+		Short: "Transfer the ownership of a delegation to a new delegator",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of the delegation to a validator to a new delegator,
+e.g. a new key or a multisig, without unbonding. The rewards accrued by the delegation
+are withdrawn beforehand. The transaction must be signed by both the delegator and the
+new delegator.
+
+Example:
+$ %s tx staking edit-delegation cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj cosmos1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey --generate-only > unsigned.json
+$ %s tx sign unsigned.json --from mykey > signed.json
+$ %s tx sign signed.json --from newkey > signed-both.json
+$ %s tx broadcast signed-both.json
+`,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			newDelAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEditDelegation(delAddr, valAddr, newDelAddr)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 }
 
 //__________________________________________________________
@@ -27,6 +27,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the aggregated stake of a delegator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/total_stake",
+		delegatorTotalStakeHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Get the maximum amount a delegator may delegate
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/max_delegatable",
+		delegatorMaxDelegatableHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all staking txs (i.e msgs) from a delegator
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/txs",
@@ -63,6 +75,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		redelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query the remaining shares a delegator may redelegate from a validator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redelegation_budget/{validatorSrcAddr}",
+		redelegationBudgetHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all validators
 	r.HandleFunc(
 		"/staking/validators",
@@ -117,6 +135,16 @@ func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.Hand
 	return queryDelegator(cliCtx, "custom/staking/delegatorUnbondingDelegations")
 }
 
+// HTTP request handler to query the aggregated stake of a delegator
+func delegatorTotalStakeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorTotalStake))
+}
+
+// HTTP request handler to query the maximum amount a delegator may delegate
+func delegatorMaxDelegatableHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorMaxDelegatable))
+}
+
 // HTTP request handler to query all staking txs (msgs) from a delegator
 func delegatorTxsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -218,7 +246,7 @@ func redelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		if len(bechSrcValidatorAddr) != 0 {
-			srcValidatorAddr, err := sdk.ValAddressFromBech32(bechSrcValidatorAddr)
+			srcValidatorAddr, err := sdk.ValAddressFromAccOrValBech32(bechSrcValidatorAddr)
 			if err != nil {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 				return
@@ -227,7 +255,7 @@ func redelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		if len(bechDstValidatorAddr) != 0 {
-			dstValidatorAddr, err := sdk.ValAddressFromBech32(bechDstValidatorAddr)
+			dstValidatorAddr, err := sdk.ValAddressFromAccOrValBech32(bechDstValidatorAddr)
 			if err != nil {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 				return
@@ -252,6 +280,48 @@ func redelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// HTTP request handler to query the redelegation budget of a delegator
+func redelegationBudgetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		srcValidatorAddr, err := sdk.ValAddressFromAccOrValBech32(vars["validatorSrcAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryBondsParams(delegatorAddr, srcValidatorAddr)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		endpoint := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRedelegationBudget)
+		res, height, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query a delegation
 func delegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation))
@@ -47,7 +47,7 @@ func queryBonds(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
 			return
 		}
 
-		validatorAddr, err := sdk.ValAddressFromBech32(bech32validator)
+		validatorAddr, err := sdk.ValAddressFromAccOrValBech32(bech32validator)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -117,7 +117,7 @@ func queryValidator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 		vars := mux.Vars(r)
 		bech32validatorAddr := vars["validatorAddr"]
 
-		validatorAddr, err := sdk.ValAddressFromBech32(bech32validatorAddr)
+		validatorAddr, err := sdk.ValAddressFromAccOrValBech32(bech32validatorAddr)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -89,6 +89,8 @@ func InitGenesis(
 		}
 	}
 
+	keeper.SetUnbondingID(ctx, data.UnbondingID)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -133,6 +135,7 @@ func InitGenesis(
 			update.Power = lv.Power // keep the next-val-set offset, use the last power for the first block
 			res = append(res, update)
 		}
+		keeper.SetLastValidatorSetSize(ctx, uint32(len(data.LastValidatorPowers)))
 	} else {
 		res = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	}
@@ -147,7 +150,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	lastTotalPower := keeper.GetLastTotalPower(ctx)
 	validators := keeper.GetAllValidators(ctx)
-	delegations := keeper.GetAllDelegations(ctx)
+	var delegations []types.Delegation
+	keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		delegations = append(delegations, delegation)
+		return false
+	})
 	var unbondingDelegations []types.UnbondingDelegation
 	keeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) (stop bool) {
 		unbondingDelegations = append(unbondingDelegations, ubd)
@@ -172,6 +179,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		Delegations:          delegations,
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
+		UnbondingID:          keeper.GetUnbondingID(ctx),
 		Exported:             true,
 	}
 }
@@ -203,6 +211,46 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	return validateGenesisStateUnbondingIDs(data)
+}
+
+// validateGenesisStateUnbondingIDs checks that the ids of the unbonding
+// delegation and redelegation entries are unique and not above the unbonding id
+// counter, so that the counter continues without reusing them. Entries without
+// an id are not checked.
+func validateGenesisStateUnbondingIDs(data types.GenesisState) error {
+	ids := make(map[uint64]bool)
+	checkID := func(id uint64) error {
+		if id == 0 {
+			return nil
+		}
+		if ids[id] {
+			return fmt.Errorf("duplicate unbonding id in genesis state: %d", id)
+		}
+		if id > data.UnbondingID {
+			return fmt.Errorf("unbonding id %d is above the unbonding id counter %d in genesis state", id, data.UnbondingID)
+		}
+
+		ids[id] = true
+		return nil
+	}
+
+	for _, ubd := range data.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			if err := checkID(entry.UnbondingId); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, red := range data.Redelegations {
+		for _, entry := range red.Entries {
+			if err := checkID(entry.UnbondingId); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package staking
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -46,6 +47,7 @@ func TestInitGenesis(t *testing.T) {
 	validators[1].DelegatorShares = valTokens.ToDec()
 
 	genesisState := types.NewGenesisState(params, validators, delegations)
+	genesisState.UnbondingID = 5
 	vals := InitGenesis(ctx, keeper, accKeeper, bk, supplyKeeper, genesisState)
 
 	actualGenesis := ExportGenesis(ctx, keeper)
@@ -53,6 +55,11 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Delegations, actualGenesis.Delegations)
 	require.EqualValues(t, keeper.GetAllValidators(ctx), actualGenesis.Validators)
 
+	// the unbonding id counter is kept even though no entry holds its id, so
+	// that the ids of completed entries are not reused
+	require.Equal(t, uint64(5), actualGenesis.UnbondingID)
+	require.Equal(t, uint64(6), keeper.IncrementUnbondingID(ctx))
+
 	// now make sure the validators are bonded and intra-tx counters are correct
 	resVal, found := keeper.GetValidator(ctx, sdk.ValAddress(keep.Addrs[0]))
 	require.True(t, found)
@@ -112,6 +119,13 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	delAddr, valAddr, valDstAddr := keep.Addrs[0], sdk.ValAddress(keep.Addrs[1]), sdk.ValAddress(keep.Addrs[2])
+	ubd := types.NewUnbondingDelegation(delAddr, valAddr, 1, time.Unix(0, 0), sdk.OneInt())
+	ubd.AddEntry(1, time.Unix(0, 0), sdk.OneInt())
+	ubd.Entries[0].UnbondingId, ubd.Entries[1].UnbondingId = 1, 2
+	red := types.NewRedelegation(delAddr, valAddr, valDstAddr, 1, time.Unix(0, 0), sdk.OneInt(), sdk.OneDec())
+	red.Entries[0].UnbondingId = 3
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -132,6 +146,24 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate unbonding ids
+		{"unbonding ids up to the counter", func(data *types.GenesisState) {
+			data.UnbondingDelegations = []types.UnbondingDelegation{ubd}
+			data.Redelegations = []types.Redelegation{red}
+			data.UnbondingID = 3
+		}, false},
+		{"unbonding delegation id above the counter", func(data *types.GenesisState) {
+			data.UnbondingDelegations = []types.UnbondingDelegation{ubd}
+			data.UnbondingID = 1
+		}, true},
+		{"redelegation id above the counter", func(data *types.GenesisState) {
+			data.Redelegations = []types.Redelegation{red}
+			data.UnbondingID = 2
+		}, true},
+		{"duplicate unbonding id", func(data *types.GenesisState) {
+			data.UnbondingDelegations = []types.UnbondingDelegation{ubd, ubd}
+			data.UnbondingID = 3
+		}, true},
 	}
 
 	for _, tt := range tests {
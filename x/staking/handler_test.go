@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics/generic"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -527,6 +528,95 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 	require.NotNil(t, res, "msgUnbond: %v\nshares: %s\nleftBonded: %s\n", msgUndelegate, unbondAmt, leftBonded)
 }
 
+func TestEditDelegation(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
+
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	delegatorAddr, newDelegatorAddr := keep.Addrs[1], keep.Addrs[2]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond)
+	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	bondAmt := sdk.NewInt(100)
+	for _, addr := range []sdk.AccAddress{delegatorAddr, newDelegatorAddr} {
+		res, err = handleMsgDelegate(ctx, NewTestMsgDelegate(addr, validatorAddr, bondAmt), keeper)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	}
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+
+	// the delegation is merged with the existing delegation of the new delegator
+	msgEditDelegation := NewMsgEditDelegation(delegatorAddr, validatorAddr, newDelegatorAddr)
+	res, err = handleMsgEditDelegation(ctx, msgEditDelegation, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+
+	delegation, found := keeper.GetDelegation(ctx, newDelegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(bondAmt.MulRaw(2)), delegation.Shares)
+
+	// the validator is not affected
+	updatedValidator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, validator, updatedValidator)
+	require.Empty(t, keeper.ApplyAndReturnValidatorSetUpdates(ctx))
+
+	// the delegation no longer exists
+	_, err = handleMsgEditDelegation(ctx, msgEditDelegation, keeper)
+	require.True(t, types.ErrNoDelegation.Is(err))
+
+	// the self-delegation cannot be transferred
+	msgEditDelegation = NewMsgEditDelegation(sdk.AccAddress(validatorAddr), validatorAddr, newDelegatorAddr)
+	_, err = handleMsgEditDelegation(ctx, msgEditDelegation, keeper)
+	require.True(t, types.ErrTransferSelfDelegation.Is(err))
+}
+
+func TestEditDelegationReceivingRedelegation(t *testing.T) {
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+
+	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
+	delegatorAddr, newDelegatorAddr := keep.Addrs[2], keep.Addrs[3]
+
+	// create the validators
+	valTokens := sdk.TokensFromConsensusPower(10)
+	for i, addr := range []sdk.ValAddress{validatorAddr, validatorAddr2} {
+		msgCreateValidator := NewTestMsgCreateValidator(addr, keep.PKs[i], valTokens)
+		_, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.NoError(t, err)
+	}
+
+	_, err := handleMsgDelegate(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens), keeper)
+	require.NoError(t, err)
+
+	// end block to bond them
+	EndBlocker(ctx, keeper)
+
+	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2))
+	msgBeginRedelegate := NewMsgBeginRedelegate(delegatorAddr, validatorAddr, validatorAddr2, redAmt)
+	_, err = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.NoError(t, err)
+
+	// the delegation to the source validator can be transferred
+	msgEditDelegation := NewMsgEditDelegation(delegatorAddr, validatorAddr, newDelegatorAddr)
+	_, err = handleMsgEditDelegation(ctx, msgEditDelegation, keeper)
+	require.NoError(t, err)
+
+	// the delegation receiving the redelegation cannot
+	msgEditDelegation = NewMsgEditDelegation(delegatorAddr, validatorAddr2, newDelegatorAddr)
+	_, err = handleMsgEditDelegation(ctx, msgEditDelegation, keeper)
+	require.True(t, types.ErrTransferReceivingRedelegation.Is(err))
+}
+
 func TestMultipleMsgCreateValidator(t *testing.T) {
 	initPower := int64(1000)
 	initTokens := sdk.TokensFromConsensusPower(initPower)
@@ -873,6 +963,61 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	require.False(t, found, "should be removed from state")
 }
 
+func TestHandlerMetrics(t *testing.T) {
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
+
+	m := &Metrics{
+		Delegations:        generic.NewCounter("delegations"),
+		DelegationAmount:   generic.NewHistogram("delegation_amount", 50),
+		Undelegations:      generic.NewCounter("undelegations"),
+		UndelegationAmount: generic.NewHistogram("undelegation_amount", 50),
+		Redelegations:      generic.NewCounter("redelegations"),
+		RedelegationAmount: generic.NewHistogram("redelegation_amount", 50),
+		Slashes:            generic.NewCounter("slashes"),
+		SlashedAmount:      generic.NewHistogram("slashed_amount", 50),
+	}
+	keeper.SetMetrics(m)
+
+	// the self-delegations of new validators are observed as delegations
+	for i, valAddr := range []sdk.ValAddress{validatorAddr, validatorAddr2} {
+		msgCreateValidator := NewTestMsgCreateValidator(valAddr, keep.PKs[i], sdk.NewInt(10))
+		_, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.NoError(t, err)
+	}
+	require.Equal(t, float64(2), m.Delegations.(*generic.Counter).Value())
+
+	// nothing is recorded in CheckTx mode
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(4))
+	msgDelegate := NewMsgDelegate(keep.Addrs[2], validatorAddr, coin)
+	_, err := handleMsgDelegate(ctx.WithIsCheckTx(true), msgDelegate, keeper)
+	require.NoError(t, err)
+	require.Equal(t, float64(2), m.Delegations.(*generic.Counter).Value())
+
+	_, err = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.NoError(t, err)
+	require.Equal(t, float64(3), m.Delegations.(*generic.Counter).Value())
+
+	// failed messages are not observed
+	_, err = handleMsgUndelegate(ctx, NewMsgUndelegate(keep.Addrs[2], validatorAddr2, coin), keeper)
+	require.Error(t, err)
+	require.Zero(t, m.Undelegations.(*generic.Counter).Value())
+
+	// redelegations are neither observed as undelegations nor as delegations
+	msgBeginRedelegate := NewMsgBeginRedelegate(keep.Addrs[2], validatorAddr, validatorAddr2, coin)
+	_, err = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), m.Redelegations.(*generic.Counter).Value())
+	require.Equal(t, float64(4), m.RedelegationAmount.(*generic.Histogram).Quantile(0.5))
+	require.Equal(t, float64(3), m.Delegations.(*generic.Counter).Value())
+	require.Zero(t, m.Undelegations.(*generic.Counter).Value())
+
+	_, err = handleMsgUndelegate(ctx, NewMsgUndelegate(keep.Addrs[2], validatorAddr2, coin), keeper)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), m.Undelegations.(*generic.Counter).Value())
+	require.Equal(t, float64(4), m.UndelegationAmount.(*generic.Histogram).Quantile(0.5))
+}
+
 func TestRedelegationPeriod(t *testing.T) {
 	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
@@ -52,14 +52,13 @@ func (k Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegati
 // return all delegations to a specific validator. Useful for querier.
 func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) { //nolint:interfacer
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsByValIndexKey(valAddr))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
-		if delegation.GetValidatorAddr().Equals(valAddr) {
-			delegations = append(delegations, delegation)
-		}
+		key := types.GetDelegationKeyFromValIndexKey(iterator.Key())
+		delegation := types.MustUnmarshalDelegation(k.cdc, store.Get(key))
+		delegations = append(delegations, delegation)
 	}
 	return delegations
 }
@@ -89,6 +88,7 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress), b)
+	store.Set(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress), []byte{}) // index, store empty bytes
 }
 
 // remove a delegation
@@ -97,6 +97,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	k.BeforeDelegationRemoved(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+	store.Delete(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
 }
 
 // return a given amount of all the delegator unbonding-delegations
@@ -183,6 +184,10 @@ func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDeleg
 	key := types.GetUBDKey(ubd.DelegatorAddress, ubd.ValidatorAddress)
 	store.Set(key, bz)
 	store.Set(types.GetUBDByValIndexKey(ubd.DelegatorAddress, ubd.ValidatorAddress), []byte{}) // index, store empty bytes
+
+	for _, entry := range ubd.Entries {
+		k.setUnbondingIndex(ctx, entry.UnbondingId, key)
+	}
 }
 
 // remove the unbonding delegation object and associated index
@@ -191,10 +196,16 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 	key := types.GetUBDKey(ubd.DelegatorAddress, ubd.ValidatorAddress)
 	store.Delete(key)
 	store.Delete(types.GetUBDByValIndexKey(ubd.DelegatorAddress, ubd.ValidatorAddress))
+
+	for _, entry := range ubd.Entries {
+		k.deleteUnbondingIndex(ctx, entry.UnbondingId)
+	}
 }
 
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
-// the given addresses. It creates the unbonding delegation if it does not exist
+// the given addresses. It creates the unbonding delegation if it does not exist.
+// The entry is assigned a new unbonding id which is passed to the unbonding
+// hooks.
 func (k Keeper) SetUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	creationHeight int64, minTime time.Time, balance sdk.Int,
@@ -207,7 +218,11 @@ func (k Keeper) SetUnbondingDelegationEntry(
 		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance)
 	}
 
+	id := k.IncrementUnbondingID(ctx)
+	ubd.Entries[len(ubd.Entries)-1].UnbondingId = id
+
 	k.SetUnbondingDelegation(ctx, ubd)
+	k.AfterUnbondingInitiated(ctx, id)
 	return ubd
 }
 
@@ -261,7 +276,7 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (m
 	store := ctx.KVStore(k.storeKey)
 
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.BlockHeader().Time)
+	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.HeaderTime())
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
 		timeslice := types.DVPairs{}
 		value := unbondingTimesliceIterator.Value()
@@ -355,6 +370,10 @@ func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
 	store.Set(key, bz)
 	store.Set(types.GetREDByValSrcIndexKey(red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress), []byte{})
 	store.Set(types.GetREDByValDstIndexKey(red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress), []byte{})
+
+	for _, entry := range red.Entries {
+		k.setUnbondingIndex(ctx, entry.UnbondingId, key)
+	}
 }
 
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
@@ -372,7 +391,12 @@ func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 		red = types.NewRedelegation(delegatorAddr, validatorSrcAddr,
 			validatorDstAddr, creationHeight, minTime, balance, sharesDst)
 	}
+
+	id := k.IncrementUnbondingID(ctx)
+	red.Entries[len(red.Entries)-1].UnbondingId = id
+
 	k.SetRedelegation(ctx, red)
+	k.AfterUnbondingInitiated(ctx, id)
 	return red
 }
 
@@ -398,6 +422,10 @@ func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
 	store.Delete(redKey)
 	store.Delete(types.GetREDByValSrcIndexKey(red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress))
 	store.Delete(types.GetREDByValDstIndexKey(red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress))
+
+	for _, entry := range red.Entries {
+		k.deleteUnbondingIndex(ctx, entry.UnbondingId)
+	}
 }
 
 // redelegation queue timeslice operations
@@ -453,7 +481,7 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	store := ctx.KVStore(k.storeKey)
 
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
+	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.HeaderTime())
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
 		timeslice := types.DVVTriplets{}
 		value := redelegationTimesliceIterator.Value()
@@ -466,6 +494,14 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	return matureRedelegations
 }
 
+// GetMaxDelegatable returns the maximum amount of the bond denom a delegator
+// may delegate, which is its balance. For vesting accounts this includes the
+// coins which are still vesting and thus locked from being spent, as the
+// delegation of vesting coins is tracked by the account upon DelegateCoins.
+func (k Keeper) GetMaxDelegatable(ctx sdk.Context, delAddr sdk.AccAddress) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, delAddr, k.BondDenom(ctx))
+}
+
 // Perform a delegation, set/update everything necessary within the store.
 // tokenSrc indicates the bond status of the incoming funds.
 func (k Keeper) Delegate(
@@ -621,7 +657,7 @@ func (k Keeper) getBeginInfo(
 	case !found || validator.IsBonded():
 
 		// the longest wait - just unbonding period from now
-		completionTime = ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+		completionTime = ctx.HeaderTime().Add(k.UnbondingTime(ctx))
 		height = ctx.BlockHeight()
 		return completionTime, height, false
 
@@ -640,23 +676,29 @@ func (k Keeper) getBeginInfo(
 // will verify that the unbonding entries between the delegator and validator
 // are not exceeded and unbond the staked tokens (based on shares) by creating
 // an unbonding object and inserting it into the unbonding queue which will be
-// processed during the staking EndBlocker.
+// processed during the staking EndBlocker. It returns the completion time and
+// the amount of tokens unbonded, which includes the rest of the delegation if
+// it would fall below the MinDelegation parameter.
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
-) (time.Time, error) {
+) (time.Time, sdk.Int, error) {
 
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegatorForAddress
+		return time.Time{}, sdk.ZeroInt(), types.ErrNoDelegatorForAddress
 	}
 
 	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+		return time.Time{}, sdk.ZeroInt(), types.ErrMaxUnbondingDelegationEntries
 	}
 
+	// unbond the rest of the delegation as well if it would fall below the
+	// minimum delegation
+	sharesAmount = k.addDustShares(ctx, delAddr, validator, sharesAmount)
+
 	returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, sdk.ZeroInt(), err
 	}
 
 	// transfer the validator tokens to the not bonded pool
@@ -664,11 +706,82 @@ func (k Keeper) Undelegate(
 		k.bondedTokensToNotBonded(ctx, returnAmount)
 	}
 
-	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	completionTime := ctx.HeaderTime().Add(k.UnbondingTime(ctx))
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
-	return completionTime, nil
+	return completionTime, returnAmount, nil
+}
+
+// addDustShares returns the shares to unbond from a delegation increased by the
+// rest of the delegation if the tokens of the rest would be less than the
+// MinDelegation parameter. The rest of a self-delegation is never added.
+func (k Keeper) addDustShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, shares sdk.Dec,
+) sdk.Dec {
+
+	minDelegation := k.MinDelegation(ctx)
+	if !minDelegation.IsPositive() || delAddr.Equals(validator.OperatorAddress) {
+		return shares
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, validator.OperatorAddress)
+	if !found || delegation.Shares.LTE(shares) {
+		return shares
+	}
+
+	if isDust(validator, delegation.Shares.Sub(shares), minDelegation) {
+		return delegation.Shares
+	}
+
+	return shares
+}
+
+// unbondDustDelegations fully unbonds the delegations to a validator whose
+// tokens are less than the MinDelegation parameter, e.g. after the validator
+// has been slashed. The self-delegation and delegations which have reached the
+// maximum number of unbonding entries are kept. Nothing is unbonded from a
+// validator without a self-delegation, as unbonding its last delegation would
+// remove an unbonded validator while it is being slashed.
+func (k Keeper) unbondDustDelegations(ctx sdk.Context, validator types.Validator) {
+	minDelegation := k.MinDelegation(ctx)
+	if !minDelegation.IsPositive() {
+		return
+	}
+
+	valAddr := validator.OperatorAddress
+	if _, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); !found {
+		return
+	}
+
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		delAddr := delegation.DelegatorAddress
+		if delAddr.Equals(valAddr) || !isDust(validator, delegation.Shares, minDelegation) ||
+			k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+			continue
+		}
+
+		completionTime, amount, err := k.Undelegate(ctx, delAddr, valAddr, delegation.Shares)
+		if err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbond,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			),
+		)
+	}
+}
+
+// isDust returns true if the given shares of a validator are worth less than
+// the minimum delegation.
+func isDust(validator types.Validator, shares sdk.Dec, minDelegation sdk.Int) bool {
+	return validator.TokensFromShares(shares).TruncateInt().LT(minDelegation)
 }
 
 // CompleteUnbondingWithAmount completes the unbonding of all mature entries in
@@ -682,13 +795,15 @@ func (k Keeper) CompleteUnbondingWithAmount(ctx sdk.Context, delAddr sdk.AccAddr
 
 	bondDenom := k.GetParams(ctx).BondDenom
 	balances := sdk.NewCoins()
-	ctxTime := ctx.BlockHeader().Time
+	ctxTime := ctx.HeaderTime()
 
-	// loop through all the entries and complete unbonding mature entries
+	// loop through all the entries and complete unbonding mature entries which
+	// are not on hold
 	for i := 0; i < len(ubd.Entries); i++ {
 		entry := ubd.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			ubd.RemoveEntry(int64(i))
+			k.deleteUnbondingIndex(ctx, entry.UnbondingId)
 			i--
 
 			// track undelegation only when remaining or truncated shares are non-zero
@@ -751,6 +866,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	if err := k.checkRedelegationBudget(ctx, delAddr, srcValidator, dstValidator, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -780,6 +899,139 @@ func (k Keeper) BeginRedelegation(
 	return completionTime, nil
 }
 
+// TransferDelegation transfers the ownership of the delegation of delAddr to
+// valAddr to newDelAddr, merging it with any existing delegation of newDelAddr
+// to the validator. The rewards accrued by both delegations are withdrawn by the
+// hooks before the shares are moved. It returns the transferred shares.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, newDelAddr sdk.AccAddress,
+) (sdk.Dec, error) {
+
+	if delAddr.Equals(newDelAddr) {
+		return sdk.ZeroDec(), types.ErrTransferToSameDelegator
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoDelegation
+	}
+
+	// the self-delegation backs the minimum self delegation of the validator
+	if delAddr.Equals(valAddr) {
+		return sdk.ZeroDec(), types.ErrTransferSelfDelegation
+	}
+
+	// the delegation must remain slashable for infractions of the source
+	// validators of its redelegations
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.ZeroDec(), types.ErrTransferReceivingRedelegation
+	}
+
+	// vesting accounts track their delegated coins, which would get out of sync
+	if !k.bankKeeper.LockedCoins(ctx, delAddr).IsZero() {
+		return sdk.ZeroDec(), types.ErrTransferLockedCoins
+	}
+
+	// call the before-modification hook and remove the delegation
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	k.RemoveDelegation(ctx, delegation)
+
+	newDelegation, found := k.GetDelegation(ctx, newDelAddr, valAddr)
+	if !found {
+		newDelegation = types.NewDelegation(newDelAddr, valAddr, sdk.ZeroDec())
+	}
+
+	// call the appropriate hook if present
+	if found {
+		k.BeforeDelegationSharesModified(ctx, newDelAddr, valAddr)
+	} else {
+		k.BeforeDelegationCreated(ctx, newDelAddr, valAddr)
+	}
+
+	newDelegation.Shares = newDelegation.Shares.Add(delegation.Shares)
+	k.SetDelegation(ctx, newDelegation)
+
+	// call the after-modification hook
+	k.AfterDelegationModified(ctx, newDelAddr, valAddr)
+
+	return delegation.Shares, nil
+}
+
+// GetRedelegationBudget returns the destination shares a delegator may still
+// redelegate from valSrcAddr before the MaxRedelegationSharesPerValidator
+// parameter is reached. The immature redelegations to all destination
+// validators are counted.
+func (k Keeper) GetRedelegationBudget(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress,
+) types.RedelegationBudget {
+
+	budget := types.RedelegationBudget{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		Limit:               k.MaxRedelegationSharesPerValidator(ctx),
+		Redelegating:        sdk.ZeroDec(),
+		Remaining:           sdk.ZeroDec(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsByDelFromValSrcKey(delAddr, valSrcAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		red := types.MustUnmarshalRED(k.cdc, iterator.Value())
+		for _, entry := range red.Entries {
+			budget.Redelegating = budget.Redelegating.Add(entry.SharesDst)
+		}
+	}
+
+	budget.Limited = budget.Limit.IsPositive()
+	if budget.Limited && budget.Limit.GT(budget.Redelegating) {
+		budget.Remaining = budget.Limit.Sub(budget.Redelegating)
+	}
+
+	return budget
+}
+
+// checkRedelegationBudget returns an error if redelegating the given shares of
+// the source validator would exceed the redelegation budget of the delegator.
+// The destination shares are computed as unbond and Delegate would, so that the
+// check is done before the state is changed. Redelegations from an unbonded
+// validator complete at once and are not limited.
+func (k Keeper) checkRedelegationBudget(
+	ctx sdk.Context, delAddr sdk.AccAddress, srcValidator, dstValidator types.Validator, shares sdk.Dec,
+) error {
+
+	budget := k.GetRedelegationBudget(ctx, delAddr, srcValidator.OperatorAddress)
+	if !budget.Limited || srcValidator.IsUnbonded() {
+		return nil
+	}
+
+	// the last delegator shares of a validator get all of its tokens
+	tokens := srcValidator.Tokens
+	if shares.LT(srcValidator.DelegatorShares) {
+		tokens = srcValidator.TokensFromShares(shares).TruncateInt()
+	}
+
+	// the first delegation to a validator sets the exchange rate to one
+	sharesDst := tokens.ToDec()
+	if !dstValidator.DelegatorShares.IsZero() {
+		var err error
+		if sharesDst, err = dstValidator.SharesFromTokens(tokens); err != nil {
+			return err
+		}
+	}
+
+	if sharesDst.GT(budget.Remaining) {
+		return sdkerrors.Wrapf(types.ErrMaxRedelegationShares, "%s > %s remaining", sharesDst, budget.Remaining)
+	}
+
+	return nil
+}
+
 // CompleteRedelegationWithAmount completes the redelegations of all mature entries in the
 // retrieved redelegation object and returns the total redelegation (initial)
 // balance or an error upon failure.
@@ -794,13 +1046,15 @@ func (k Keeper) CompleteRedelegationWithAmount(
 
 	bondDenom := k.GetParams(ctx).BondDenom
 	balances := sdk.NewCoins()
-	ctxTime := ctx.BlockHeader().Time
+	ctxTime := ctx.HeaderTime()
 
 	// loop through all the entries and complete mature redelegation entries
+	// which are not on hold
 	for i := 0; i < len(red.Entries); i++ {
 		entry := red.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			red.RemoveEntry(int64(i))
+			k.deleteUnbondingIndex(ctx, entry.UnbondingId)
 			i--
 
 			if !entry.InitialBalance.IsZero() {
@@ -871,3 +1125,35 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// ValidateUnbondingAmount validates that a given amount may be unbonded from a
+// source validator and redelegated to a destination validator. In addition to
+// the checks of ValidateUnbondAmount, the destination shares of the
+// redelegation must not exceed the redelegation budget of the delegator (see
+// GetRedelegationBudget). If the amount is valid, the shares to unbond from the
+// source validator are returned, otherwise an error is returned.
+func (k Keeper) ValidateUnbondingAmount(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amt sdk.Int,
+) (shares sdk.Dec, err error) {
+
+	if bytes.Equal(valSrcAddr, valDstAddr) {
+		return shares, types.ErrSelfRedelegation
+	}
+
+	shares, err = k.ValidateUnbondAmount(ctx, delAddr, valSrcAddr, amt)
+	if err != nil {
+		return shares, err
+	}
+
+	srcValidator := k.mustGetValidator(ctx, valSrcAddr)
+	dstValidator, found := k.GetValidator(ctx, valDstAddr)
+	if !found {
+		return shares, types.ErrBadRedelegationDst
+	}
+
+	if err := k.checkRedelegationBudget(ctx, delAddr, srcValidator, dstValidator, shares); err != nil {
+		return shares, err
+	}
+
+	return shares, nil
+}
@@ -235,7 +235,7 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	var completionTime time.Time
 	for i := uint32(0); i < maxEntries; i++ {
 		var err error
-		completionTime, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+		completionTime, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
 		require.NoError(t, err)
 	}
 
@@ -248,7 +248,7 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	oldNotBonded = bk.GetBalance(ctx, keeper.GetNotBondedPool(ctx).GetAddress(), bondDenom).Amount
 
 	// an additional unbond should fail due to max entries
-	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
 	require.Error(t, err)
 
 	newBonded = bk.GetBalance(ctx, keeper.GetBondedPool(ctx).GetAddress(), bondDenom).Amount
@@ -270,7 +270,7 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	oldNotBonded = bk.GetBalance(ctx, keeper.GetNotBondedPool(ctx).GetAddress(), bondDenom).Amount
 
 	// unbonding  should work again
-	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
 	require.NoError(t, err)
 
 	newBonded = bk.GetBalance(ctx, keeper.GetBondedPool(ctx).GetAddress(), bondDenom).Amount
@@ -330,7 +330,7 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 	keeper.SetDelegation(ctx, delegation)
 
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	_, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], sdk.TokensFromConsensusPower(6).ToDec())
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], sdk.TokensFromConsensusPower(6).ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -403,7 +403,7 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 
 	// unbond the all self-delegation to put validator in unbonding state
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	_, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], delTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], delTokens.ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -422,7 +422,7 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 	ctx = ctx.WithBlockTime(blockTime2)
 
 	// unbond some of the other delegation's shares
-	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(6))
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(6))
 	require.NoError(t, err)
 
 	// retrieve the unbonding delegation
@@ -478,7 +478,7 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	ctx = ctx.WithBlockTime(time.Unix(333, 0))
 
 	// unbond the all self-delegation to put validator in unbonding state
-	_, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], valTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], valTokens.ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -502,12 +502,12 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 
 	// unbond some of the other delegation's shares
 	unbondTokens := sdk.TokensFromConsensusPower(6)
-	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
 	require.NoError(t, err)
 
 	// unbond rest of the other delegation's shares
 	remainingTokens := delTokens.Sub(unbondTokens)
-	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], remainingTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], remainingTokens.ToDec())
 	require.NoError(t, err)
 
 	//  now validator should now be deleted from state
@@ -562,7 +562,7 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	ctx = ctx.WithBlockTime(time.Unix(333, 0))
 
 	// unbond the all self-delegation to put validator in unbonding state
-	_, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], valTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], valTokens.ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -570,7 +570,7 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	require.Equal(t, 1, len(updates))
 
 	// unbond all the remaining delegation
-	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], delTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], delTokens.ToDec())
 	require.NoError(t, err)
 
 	// validator should still be in state and still be in unbonding state
@@ -753,6 +753,110 @@ func TestRedelegationMaxEntries(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRedelegationSharesLimit(t *testing.T) {
+	ctx, _, bk, keeper, _ := CreateTestInput(t, false, 0)
+	startTokens := sdk.TokensFromConsensusPower(30)
+	startCoins := sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))
+
+	// add bonded tokens to pool for delegations
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	oldNotBonded := bk.GetAllBalances(ctx, notBondedPool.GetAddress())
+	err := bk.SetBalances(ctx, notBondedPool.GetAddress(), oldNotBonded.Add(startCoins...))
+	require.NoError(t, err)
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a validator with a self-delegation
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	valTokens := sdk.TokensFromConsensusPower(10)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
+	require.Equal(t, valTokens, issuedShares.RoundInt())
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, selfDelegation)
+
+	// create a second validator
+	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
+	require.Equal(t, valTokens, issuedShares.RoundInt())
+
+	validator2 = TestingUpdateValidator(keeper, ctx, validator2, true)
+	require.Equal(t, sdk.Bonded, validator2.Status)
+	val1AccAddr := sdk.AccAddress(addrVals[1].Bytes())
+	keeper.SetDelegation(ctx, types.NewDelegation(val1AccAddr, addrVals[1], issuedShares))
+
+	// create a third validator
+	validator3 := types.NewValidator(addrVals[2], PKs[2], types.Description{})
+	validator3, issuedShares = validator3.AddTokensFromDel(valTokens)
+	require.Equal(t, valTokens, issuedShares.RoundInt())
+
+	validator3 = TestingUpdateValidator(keeper, ctx, validator3, true)
+	require.Equal(t, sdk.Bonded, validator3.Status)
+
+	// redelegations are not limited by default
+	budget := keeper.GetRedelegationBudget(ctx, val0AccAddr, addrVals[0])
+	require.False(t, budget.Limited)
+
+	params := keeper.GetParams(ctx)
+	params.MaxRedelegationSharesPerValidator = sdk.NewDec(5)
+	keeper.SetParams(ctx, params)
+
+	// redelegations within the limit should pass
+	var completionTime time.Time
+	for i := 0; i < 2; i++ {
+		completionTime, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(2))
+		require.NoError(t, err)
+	}
+
+	budget = keeper.GetRedelegationBudget(ctx, val0AccAddr, addrVals[0])
+	require.True(t, budget.Limited)
+	require.Equal(t, sdk.NewDec(4), budget.Redelegating)
+	require.Equal(t, sdk.NewDec(1), budget.Remaining)
+
+	// the limit is per source validator, so redelegating to another
+	// destination validator exceeding the remaining shares should fail as well,
+	// without changing the state
+	for _, dstAddr := range []sdk.ValAddress{addrVals[1], addrVals[2]} {
+		selfDelegation, found := keeper.GetDelegation(ctx, val0AccAddr, addrVals[0])
+		require.True(t, found)
+
+		_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], dstAddr, sdk.NewDec(2))
+		require.True(t, types.ErrMaxRedelegationShares.Is(err))
+
+		delegation, found := keeper.GetDelegation(ctx, val0AccAddr, addrVals[0])
+		require.True(t, found)
+		require.Equal(t, selfDelegation, delegation)
+		_, found = keeper.GetDelegation(ctx, val0AccAddr, addrVals[2])
+		require.False(t, found)
+	}
+
+	// the remaining shares can be redelegated to any destination validator
+	_, err = keeper.ValidateUnbondingAmount(ctx, val0AccAddr, addrVals[0], addrVals[2], sdk.NewInt(2))
+	require.True(t, types.ErrMaxRedelegationShares.Is(err))
+	shares, err := keeper.ValidateUnbondingAmount(ctx, val0AccAddr, addrVals[0], addrVals[2], sdk.NewInt(1))
+	require.NoError(t, err)
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[2], shares)
+	require.NoError(t, err)
+
+	budget = keeper.GetRedelegationBudget(ctx, val0AccAddr, addrVals[0])
+	require.Equal(t, sdk.NewDec(5), budget.Redelegating)
+	require.True(t, budget.Remaining.IsZero())
+
+	// redelegations of other delegators and from other source validators are
+	// not limited by it
+	_, err = keeper.BeginRedelegation(ctx, val1AccAddr, addrVals[1], addrVals[2], sdk.NewDec(4))
+	require.NoError(t, err)
+
+	// mature redelegations
+	ctx = ctx.WithBlockTime(completionTime)
+	err = keeper.CompleteRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1])
+	require.NoError(t, err)
+
+	// redelegation should work again
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(4))
+	require.NoError(t, err)
+}
+
 func TestRedelegateSelfDelegation(t *testing.T) {
 	ctx, _, bk, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromConsensusPower(30)
@@ -852,7 +956,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	ctx = ctx.WithBlockHeader(header)
 
 	// unbond the all self-delegation to put validator in unbonding state
-	_, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], delTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], delTokens.ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -929,7 +1033,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	ctx = ctx.WithBlockTime(time.Unix(333, 0))
 
 	// unbond the all self-delegation to put validator in unbonding state
-	_, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], delTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, val0AccAddr, addrVals[0], delTokens.ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -954,3 +1058,48 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestUndelegateDust(t *testing.T) {
+	ctx, keeper, params := setupHelper(t, 10)
+	params.MinDelegation = sdk.TokensFromConsensusPower(1)
+	keeper.SetParams(ctx, params)
+
+	validator := keeper.mustGetValidator(ctx, addrVals[0])
+	_, err := keeper.Delegate(ctx, addrDels[0], sdk.TokensFromConsensusPower(2), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// a delegation which stays at the minimum is kept
+	validator = keeper.mustGetValidator(ctx, addrVals[0])
+	_, _, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.TokensFromConsensusPower(1).ToDec())
+	require.NoError(t, err)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(1), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// a delegation which falls below the minimum is unbonded completely
+	_, amount, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewInt(1).ToDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(1), amount)
+
+	_, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, sdk.TokensFromConsensusPower(1), ubd.Entries[1].Balance)
+
+	// the rest of a self-delegation is never unbonded
+	valAccAddr := sdk.AccAddress(addrVals[0])
+	_, err = keeper.Delegate(ctx, valAccAddr, sdk.TokensFromConsensusPower(2), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator = keeper.mustGetValidator(ctx, addrVals[0])
+	_, _, err = keeper.Undelegate(ctx, valAccAddr, addrVals[0], sdk.TokensFromConsensusPower(2).SubRaw(1).ToDec())
+	require.NoError(t, err)
+
+	delegation, found = keeper.GetDelegation(ctx, valAccAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1), validator.TokensFromShares(delegation.Shares).TruncateInt())
+}
@@ -43,6 +43,13 @@ func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsA
 	}
 }
 
+// AfterValidatorPhasedOut - call hook if registered
+func (k Keeper) AfterValidatorPhasedOut(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorPhasedOut(ctx, consAddr, valAddr)
+	}
+}
+
 // BeforeDelegationCreated - call hook if registered
 func (k Keeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
@@ -77,3 +84,10 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// AfterUnbondingInitiated - call hook if registered
+func (k Keeper) AfterUnbondingInitiated(ctx sdk.Context, id uint64) {
+	if k.unbondingHooks != nil {
+		k.unbondingHooks.AfterUnbondingInitiated(ctx, id)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -27,6 +28,8 @@ type Keeper struct {
 	bankKeeper         types.BankKeeper
 	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
+	unbondingHooks     types.UnbondingHooks
+	metrics            *Metrics
 	paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
@@ -53,6 +56,8 @@ func NewKeeper(
 		supplyKeeper:       sk,
 		paramstore:         ps.WithKeyTable(ParamKeyTable()),
 		hooks:              nil,
+		unbondingHooks:     nil,
+		metrics:            NopMetrics(),
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
 	}
@@ -72,6 +77,37 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// Set the unbonding hooks
+func (k *Keeper) SetUnbondingHooks(uh types.UnbondingHooks) *Keeper {
+	if k.unbondingHooks != nil {
+		panic("cannot set unbonding hooks twice")
+	}
+	k.unbondingHooks = uh
+	return k
+}
+
+// GetLastValidatorSetSize returns the number of validators of the last
+// validator set, as set by the last validator set update.
+func (k Keeper) GetLastValidatorSetSize(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastValidatorSetSizeKey)
+	if bz == nil {
+		return 0
+	}
+
+	size := gogotypes.UInt32Value{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &size)
+	return size.GetValue()
+}
+
+// SetLastValidatorSetSize sets the number of validators of the last validator
+// set.
+func (k Keeper) SetLastValidatorSetSize(ctx sdk.Context, size uint32) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.UInt32Value{Value: size})
+	store.Set(types.LastValidatorSetSizeKey, bz)
+}
+
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
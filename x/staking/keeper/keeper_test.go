@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -22,3 +23,35 @@ func TestParams(t *testing.T) {
 	resParams = keeper.GetParams(ctx)
 	require.True(t, expParams.Equal(resParams))
 }
+
+func TestParamChangeWarnings(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
+
+	for i := 0; i < 3; i++ {
+		keeper.SetLastValidatorPower(ctx, addrVals[i], 1)
+	}
+
+	maxValidatorsChange := []params.ParamChange{
+		params.NewParamChange(DefaultParamspace, string(types.KeyMaxValidators), `"2"`),
+	}
+	bondDenomChange := []params.ParamChange{
+		params.NewParamChange(DefaultParamspace, string(types.KeyBondDenom), `"soup"`),
+	}
+
+	// no warnings while the bonded validators fit the validator set
+	require.Empty(t, keeper.ParamChangeWarnings(ctx, maxValidatorsChange))
+
+	newParams := keeper.GetParams(ctx)
+	newParams.MaxValidators = 2
+	newParams.BondDenom = "soup"
+	keeper.SetParams(ctx, newParams)
+
+	warnings := keeper.ParamChangeWarnings(ctx, maxValidatorsChange)
+	require.Equal(t, []string{
+		"max validators 2 is below the 3 currently bonded validators; 1 validators will be unbonded",
+	}, warnings)
+
+	warnings = keeper.ParamChangeWarnings(ctx, bondDenomChange)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "bond denom is changed to soup while 3 validators are bonded")
+}
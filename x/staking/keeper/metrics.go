@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "staking"
+)
+
+// Metrics contains metrics exposed by the staking keeper.
+type Metrics struct {
+	// Number of delegations executed.
+	Delegations metrics.Counter
+	// Histogram of delegated token amounts.
+	DelegationAmount metrics.Histogram
+	// Number of undelegations executed.
+	Undelegations metrics.Counter
+	// Histogram of undelegated token amounts.
+	UndelegationAmount metrics.Histogram
+	// Number of redelegations executed.
+	Redelegations metrics.Counter
+	// Histogram of redelegated token amounts.
+	RedelegationAmount metrics.Histogram
+	// Number of validator slashes executed.
+	Slashes metrics.Counter
+	// Histogram of burned token amounts per slash.
+	SlashedAmount metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+//
+// NOTE: The metrics are registered with the default Prometheus registry, and
+// as such, must be built at most once per process.
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	amountBuckets := stdprometheus.ExponentialBuckets(1, 10, 19)
+
+	return &Metrics{
+		Delegations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "delegations",
+			Help:      "Number of delegations executed.",
+		}, labels).With(labelsAndValues...),
+		DelegationAmount: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "delegation_amount",
+			Help:      "Delegated token amounts.",
+			Buckets:   amountBuckets,
+		}, labels).With(labelsAndValues...),
+		Undelegations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "undelegations",
+			Help:      "Number of undelegations executed.",
+		}, labels).With(labelsAndValues...),
+		UndelegationAmount: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "undelegation_amount",
+			Help:      "Undelegated token amounts.",
+			Buckets:   amountBuckets,
+		}, labels).With(labelsAndValues...),
+		Redelegations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "redelegations",
+			Help:      "Number of redelegations executed.",
+		}, labels).With(labelsAndValues...),
+		RedelegationAmount: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "redelegation_amount",
+			Help:      "Redelegated token amounts.",
+			Buckets:   amountBuckets,
+		}, labels).With(labelsAndValues...),
+		Slashes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "slashes",
+			Help:      "Number of validator slashes executed.",
+		}, labels).With(labelsAndValues...),
+		SlashedAmount: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "slashed_amount",
+			Help:      "Burned token amounts per slash.",
+			Buckets:   amountBuckets,
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Delegations:        discard.NewCounter(),
+		DelegationAmount:   discard.NewHistogram(),
+		Undelegations:      discard.NewCounter(),
+		UndelegationAmount: discard.NewHistogram(),
+		Redelegations:      discard.NewCounter(),
+		RedelegationAmount: discard.NewHistogram(),
+		Slashes:            discard.NewCounter(),
+		SlashedAmount:      discard.NewHistogram(),
+	}
+}
+
+// SetMetrics sets the metrics the keeper reports to. It must be called before
+// the keeper is copied into other keepers or modules.
+func (k *Keeper) SetMetrics(m *Metrics) *Keeper {
+	k.metrics = m
+	return k
+}
+
+// RecordDelegation observes a delegation of amt tokens. It is called by the
+// message handlers once a delegation has succeeded, so that the delegations
+// made by redelegations are not observed. Nothing is recorded in CheckTx mode.
+func (k Keeper) RecordDelegation(ctx sdk.Context, amt sdk.Int) {
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	k.metrics.Delegations.Add(1)
+	k.metrics.DelegationAmount.Observe(intToFloat64(amt))
+}
+
+// RecordUndelegation observes an undelegation of amt tokens. It is called by
+// the message handlers once an undelegation has succeeded, so that the
+// unbondings made by redelegations are not observed. Nothing is recorded in
+// CheckTx mode.
+func (k Keeper) RecordUndelegation(ctx sdk.Context, amt sdk.Int) {
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	k.metrics.Undelegations.Add(1)
+	k.metrics.UndelegationAmount.Observe(intToFloat64(amt))
+}
+
+// RecordRedelegation observes a redelegation of amt tokens. It is called by
+// the message handlers once a redelegation has succeeded. Nothing is recorded
+// in CheckTx mode.
+func (k Keeper) RecordRedelegation(ctx sdk.Context, amt sdk.Int) {
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	k.metrics.Redelegations.Add(1)
+	k.metrics.RedelegationAmount.Observe(intToFloat64(amt))
+}
+
+// recordSlash observes a slash burning amt tokens.
+func (k Keeper) recordSlash(ctx sdk.Context, amt sdk.Int) {
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	k.metrics.Slashes.Add(1)
+	k.metrics.SlashedAmount.Observe(intToFloat64(amt))
+}
+
+func intToFloat64(i sdk.Int) float64 {
+	f, _ := new(big.Float).SetInt(i.BigInt()).Float64()
+	return f
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// paginate calls cb with up to limit values stored under the given prefix,
+// starting at the key relative to the prefix, or at the first key if key is
+// empty. It returns the relative key of the first value not visited, or nil
+// if all remaining values have been visited.
+func (k Keeper) paginate(ctx sdk.Context, storePrefix, key []byte, limit int, cb func(value []byte)) (nextKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	if len(key) == 0 {
+		key = nil
+	}
+
+	iterator := store.Iterator(key, nil)
+	defer iterator.Close()
+
+	for i := 0; iterator.Valid(); iterator.Next() {
+		if i == limit {
+			return iterator.Key()
+		}
+
+		cb(iterator.Value())
+		i++
+	}
+
+	return nil
+}
+
+// GetDelegationsPaginated returns up to limit delegations, ordered by delegator
+// and validator address, starting at the given key. The returned key is passed
+// to retrieve the next page and is nil once the last page has been returned.
+func (k Keeper) GetDelegationsPaginated(
+	ctx sdk.Context, key []byte, limit int,
+) (delegations []types.Delegation, nextKey []byte) {
+
+	delegations = []types.Delegation{}
+	nextKey = k.paginate(ctx, types.DelegationKey, key, limit, func(value []byte) {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, value))
+	})
+
+	return delegations, nextKey
+}
+
+// GetUnbondingDelegationsPaginated returns up to limit unbonding delegations,
+// ordered by delegator and validator address, starting at the given key. The
+// returned key is passed to retrieve the next page and is nil once the last
+// page has been returned.
+func (k Keeper) GetUnbondingDelegationsPaginated(
+	ctx sdk.Context, key []byte, limit int,
+) (ubds []types.UnbondingDelegation, nextKey []byte) {
+
+	ubds = []types.UnbondingDelegation{}
+	nextKey = k.paginate(ctx, types.UnbondingDelegationKey, key, limit, func(value []byte) {
+		ubds = append(ubds, types.MustUnmarshalUBD(k.cdc, value))
+	})
+
+	return ubds, nextKey
+}
+
+// GetRedelegationsPaginated returns up to limit redelegations, ordered by
+// delegator, source and destination validator address, starting at the given
+// key. The returned key is passed to retrieve the next page and is nil once the
+// last page has been returned.
+func (k Keeper) GetRedelegationsPaginated(
+	ctx sdk.Context, key []byte, limit int,
+) (reds []types.Redelegation, nextKey []byte) {
+
+	reds = []types.Redelegation{}
+	nextKey = k.paginate(ctx, types.RedelegationKey, key, limit, func(value []byte) {
+		reds = append(reds, types.MustUnmarshalRED(k.cdc, value))
+	})
+
+	return reds, nextKey
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGetDelegationsPaginated(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10)
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 2; j++ {
+			keeper.SetDelegation(ctx, types.NewDelegation(Addrs[i], addrVals[j], sdk.NewDec(int64(i+j+1))))
+		}
+	}
+	all := keeper.GetAllDelegations(ctx)
+	require.Len(t, all, 6)
+
+	// walk the delegations in pages of four
+	delegations, nextKey := keeper.GetDelegationsPaginated(ctx, nil, 4)
+	require.Equal(t, all[:4], delegations)
+	require.NotNil(t, nextKey)
+
+	delegations, nextKey = keeper.GetDelegationsPaginated(ctx, nextKey, 4)
+	require.Equal(t, all[4:], delegations)
+	require.Nil(t, nextKey)
+
+	// an exact last page has no next key
+	delegations, nextKey = keeper.GetDelegationsPaginated(ctx, nil, 6)
+	require.Equal(t, all, delegations)
+	require.Nil(t, nextKey)
+
+	ubds, nextKey := keeper.GetUnbondingDelegationsPaginated(ctx, nil, 4)
+	require.Empty(t, ubds)
+	require.Nil(t, nextKey)
+}
+
+func TestGetRedelegationsPaginated(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
+
+	for i := 0; i < 3; i++ {
+		keeper.SetRedelegation(ctx, types.NewRedelegation(
+			Addrs[i], addrVals[0], addrVals[1], 0, ctx.HeaderTime(), sdk.NewInt(5), sdk.NewDec(5),
+		))
+		keeper.SetUnbondingDelegation(ctx, types.NewUnbondingDelegation(
+			Addrs[i], addrVals[0], 0, ctx.HeaderTime(), sdk.NewInt(5),
+		))
+	}
+
+	reds, nextKey := keeper.GetRedelegationsPaginated(ctx, nil, 2)
+	require.Len(t, reds, 2)
+	resReds, nextKey := keeper.GetRedelegationsPaginated(ctx, nextKey, 2)
+	require.Len(t, resReds, 1)
+	require.Nil(t, nextKey)
+	require.Equal(t, Addrs[2], resReds[0].DelegatorAddress)
+
+	ubds, nextKey := keeper.GetUnbondingDelegationsPaginated(ctx, nil, 2)
+	require.Len(t, ubds, 2)
+	resUbds, nextKey := keeper.GetUnbondingDelegationsPaginated(ctx, nextKey, 2)
+	require.Len(t, resUbds, 1)
+	require.Nil(t, nextKey)
+	require.NotEqual(t, ubds[0], resUbds[0])
+	require.NotEqual(t, ubds[1], resUbds[0])
+}
+
+func TestQueryAllDelegations(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	// query with an empty store
+	query := abci.RequestQuery{
+		Path: "/custom/staking/allDelegations",
+		Data: cdc.MustMarshalJSON(types.NewQueryPaginationParams(nil, 0)),
+	}
+	bz, err := queryAllDelegations(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var page types.QueryDelegationsPage
+	require.NoError(t, cdc.UnmarshalJSON(bz, &page))
+	require.Empty(t, page.Delegations)
+	require.Nil(t, page.NextKey)
+
+	val := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	val, _ = val.AddTokensFromDel(sdk.NewInt(30))
+	keeper.SetValidator(ctx, val)
+	for i := 0; i < 3; i++ {
+		keeper.SetDelegation(ctx, types.NewDelegation(Addrs[i], addrVals[0], sdk.NewDec(10)))
+	}
+
+	var delegations types.DelegationResponses
+	for {
+		query.Data = cdc.MustMarshalJSON(types.NewQueryPaginationParams(page.NextKey, 2))
+		bz, err = queryAllDelegations(ctx, query, keeper)
+		require.NoError(t, err)
+
+		page = types.QueryDelegationsPage{}
+		require.NoError(t, cdc.UnmarshalJSON(bz, &page))
+		require.True(t, len(page.Delegations) <= 2)
+
+		delegations = append(delegations, page.Delegations...)
+		if page.NextKey == nil {
+			break
+		}
+	}
+	require.Len(t, delegations, 3)
+}
+
+func TestPaginationLimit(t *testing.T) {
+	require.Equal(t, types.DefaultPaginationLimit, paginationLimit(0))
+	require.Equal(t, types.DefaultPaginationLimit, paginationLimit(-1))
+	require.Equal(t, 5, paginationLimit(5))
+	require.Equal(t, types.MaxPaginationLimit, paginationLimit(types.MaxPaginationLimit+1))
+}
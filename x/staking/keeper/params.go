@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +51,32 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MaxRedelegationSharesPerValidator - Maximum amount of destination shares of
+// immature redelegations per delegator and source validator. Zero, the value
+// used when the parameter is not set, disables the limit.
+func (k Keeper) MaxRedelegationSharesPerValidator(ctx sdk.Context) (res sdk.Dec) {
+	res = sdk.ZeroDec()
+	k.paramstore.GetIfExists(ctx, types.KeyMaxRedelegationSharesPerValidator, &res)
+	return
+}
+
+// MinDelegation - Minimum amount of tokens of a delegation. Zero, the value used
+// when the parameter is not set, disables the minimum.
+func (k Keeper) MinDelegation(ctx sdk.Context) (res sdk.Int) {
+	res = sdk.ZeroInt()
+	k.paramstore.GetIfExists(ctx, types.KeyMinDelegation, &res)
+	return
+}
+
+// MaxValidatorsPhaseOutRate - Maximum number of validators removed from the
+// validator set per block while it shrinks after MaxValidators has been
+// reduced. Zero, the value used when the parameter is not set, removes them at
+// once.
+func (k Keeper) MaxValidatorsPhaseOutRate(ctx sdk.Context) (res uint32) {
+	k.paramstore.GetIfExists(ctx, types.KeyMaxValidatorsPhaseOutRate, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -58,6 +85,9 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxRedelegationSharesPerValidator(ctx),
+		k.MinDelegation(ctx),
+		k.MaxValidatorsPhaseOutRate(ctx),
 	)
 }
 
@@ -65,3 +95,36 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// ParamChangeWarnings implements params.ParamChangeWarner. It is called with the
+// parameter changes already applied and returns warnings about their impact on
+// the current validator set.
+func (k Keeper) ParamChangeWarnings(ctx sdk.Context, changes []params.ParamChange) (warnings []string) {
+	var bonded int
+	k.IterateLastValidatorPowers(ctx, func(_ sdk.ValAddress, _ int64) (stop bool) {
+		bonded++
+		return false
+	})
+
+	for _, c := range changes {
+		switch c.Key {
+		case string(types.KeyMaxValidators):
+			if maxValidators := int(k.MaxValidators(ctx)); maxValidators < bonded {
+				warnings = append(warnings, fmt.Sprintf(
+					"max validators %d is below the %d currently bonded validators; %d validators will be unbonded",
+					maxValidators, bonded, bonded-maxValidators,
+				))
+			}
+
+		case string(types.KeyBondDenom):
+			if bonded > 0 {
+				warnings = append(warnings, fmt.Sprintf(
+					"bond denom is changed to %s while %d validators are bonded; existing delegations remain backed by the previous bond denom",
+					k.BondDenom(ctx), bonded,
+				))
+			}
+		}
+	}
+
+	return warnings
+}
@@ -60,6 +60,12 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 	return k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), k.BondDenom(ctx)).Amount
 }
 
+// NotBondedTokens total staking tokens supply which is not bonded
+func (k Keeper) NotBondedTokens(ctx sdk.Context) sdk.Int {
+	notBondedPool := k.GetNotBondedPool(ctx)
+	return k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), k.BondDenom(ctx)).Amount
+}
+
 // StakingTokenSupply staking tokens from the total supply
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
 	return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(k.BondDenom(ctx))
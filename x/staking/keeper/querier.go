@@ -13,8 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// creates a querier for staking REST endpoints
-func NewQuerier(k Keeper) sdk.Querier {
+// creates a querier for staking REST endpoints, which only has read access to
+// the staking state
+func NewQuerier(k ViewKeeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case types.QueryValidators:
@@ -53,6 +54,24 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryHistoricalInfo:
 			return queryHistoricalInfo(ctx, req, k)
 
+		case types.QueryDelegatorTotalStake:
+			return queryDelegatorTotalStake(ctx, req, k)
+
+		case types.QueryDelegatorMaxDelegatable:
+			return queryDelegatorMaxDelegatable(ctx, req, k)
+
+		case types.QueryRedelegationBudget:
+			return queryRedelegationBudget(ctx, req, k)
+
+		case types.QueryAllDelegations:
+			return queryAllDelegations(ctx, req, k)
+
+		case types.QueryAllUnbondingDelegations:
+			return queryAllUnbondingDelegations(ctx, req, k)
+
+		case types.QueryAllRedelegations:
+			return queryAllRedelegations(ctx, req, k)
+
 		case types.QueryPool:
 			return queryPool(ctx, k)
 
@@ -65,7 +84,7 @@ func NewQuerier(k Keeper) sdk.Querier {
 	}
 }
 
-func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryValidators(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryValidatorsParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -97,7 +116,7 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return res, nil
 }
 
-func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryValidator(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -118,7 +137,7 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	return res, nil
 }
 
-func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -126,16 +145,26 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
-	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
+	// convert while iterating as there is no index of delegations by validator
+	delegationResps := types.DelegationResponses{}
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		if !delegation.ValidatorAddress.Equals(params.ValidatorAddr) {
+			return false
+		}
+
+		var delResp types.DelegationResponse
+		delResp, err = delegationToDelegationResponse(ctx, k, delegation)
+		if err != nil {
+			return true
+		}
+
+		delegationResps = append(delegationResps, delResp)
+		return false
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if delegationResps == nil {
-		delegationResps = types.DelegationResponses{}
-	}
-
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegationResps)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -144,7 +173,7 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
-func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -153,11 +182,9 @@ func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	}
 
 	unbonds := k.GetUnbondingDelegationsFromValidator(ctx, params.ValidatorAddr)
-	if unbonds == nil {
-		unbonds = types.UnbondingDelegations{}
-	}
+	ubdResps := unbondingDelegationsToUnbondingDelegationResponses(unbonds)
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbonds)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, ubdResps)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -165,7 +192,7 @@ func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	return res, nil
 }
 
-func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -191,7 +218,7 @@ func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
-func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -200,11 +227,9 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	}
 
 	unbondingDelegations := k.GetAllUnbondingDelegations(ctx, params.DelegatorAddr)
-	if unbondingDelegations == nil {
-		unbondingDelegations = types.UnbondingDelegations{}
-	}
+	ubdResps := unbondingDelegationsToUnbondingDelegationResponses(unbondingDelegations)
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbondingDelegations)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, ubdResps)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -212,7 +237,7 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	return res, nil
 }
 
-func queryDelegatorValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryDelegatorValidators(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 
 	stakingParams := k.GetParams(ctx)
@@ -235,7 +260,7 @@ func queryDelegatorValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
-func queryDelegatorValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryDelegatorValidator(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryBondsParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -256,7 +281,7 @@ func queryDelegatorValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) (
 	return res, nil
 }
 
-func queryDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryDelegation(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryBondsParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -282,7 +307,7 @@ func queryDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return res, nil
 }
 
-func queryUnbondingDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryUnbondingDelegation(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryBondsParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -295,7 +320,7 @@ func queryUnbondingDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, types.ErrNoUnbondingDelegation
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbond)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewUnbondingDelegationResponse(unbond))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -303,7 +328,7 @@ func queryUnbondingDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
-func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryRedelegationParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -344,7 +369,61 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 	return res, nil
 }
 
-func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+func queryDelegatorTotalStake(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	stake := k.GetDelegatorTotalStake(ctx, params.DelegatorAddr)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, stake)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDelegatorMaxDelegatable(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	delegatable := k.GetMaxDelegatable(ctx, params.DelegatorAddr)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegatable)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRedelegationBudget(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
+	var params types.QueryBondsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	budget := k.GetRedelegationBudget(ctx, params.DelegatorAddr, params.ValidatorAddr)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, budget)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
 	var params types.QueryHistoricalInfoParams
 
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -365,9 +444,78 @@ func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]by
 	return res, nil
 }
 
-func queryPool(ctx sdk.Context, k Keeper) ([]byte, error) {
-	bondDenom := k.BondDenom(ctx)
+func queryAllDelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
+	var params types.QueryPaginationParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	delegations, nextKey := k.GetDelegationsPaginated(ctx, params.Key, paginationLimit(params.Limit))
+	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryDelegationsPage{
+		Delegations: delegationResps,
+		NextKey:     nextKey,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAllUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
+	var params types.QueryPaginationParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	ubds, nextKey := k.GetUnbondingDelegationsPaginated(ctx, params.Key, paginationLimit(params.Limit))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryUnbondingDelegationsPage{
+		UnbondingDelegations: unbondingDelegationsToUnbondingDelegationResponses(ubds),
+		NextKey:              nextKey,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAllRedelegations(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, error) {
+	var params types.QueryPaginationParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	reds, nextKey := k.GetRedelegationsPaginated(ctx, params.Key, paginationLimit(params.Limit))
+	redResps, err := redelegationsToRedelegationResponses(ctx, k, reds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.QueryRedelegationsPage{
+		Redelegations: redResps,
+		NextKey:       nextKey,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
 
+func queryPool(ctx sdk.Context, k ViewKeeper) ([]byte, error) {
 	bondedPool := k.GetBondedPool(ctx)
 	notBondedPool := k.GetNotBondedPool(ctx)
 	if bondedPool == nil || notBondedPool == nil {
@@ -375,8 +523,8 @@ func queryPool(ctx sdk.Context, k Keeper) ([]byte, error) {
 	}
 
 	pool := types.NewPool(
-		k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount,
-		k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount,
+		k.NotBondedTokens(ctx),
+		k.TotalBondedTokens(ctx),
 	)
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pool)
@@ -387,7 +535,7 @@ func queryPool(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
-func queryParameters(ctx sdk.Context, k Keeper) ([]byte, error) {
+func queryParameters(ctx sdk.Context, k ViewKeeper) ([]byte, error) {
 	params := k.GetParams(ctx)
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, params)
@@ -401,7 +549,20 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, error) {
 //______________________________________________________
 // util
 
-func delegationToDelegationResponse(ctx sdk.Context, k Keeper, del types.Delegation) (types.DelegationResponse, error) {
+// paginationLimit returns the page size of a paginated query given the
+// requested limit.
+func paginationLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return types.DefaultPaginationLimit
+	case limit > types.MaxPaginationLimit:
+		return types.MaxPaginationLimit
+	default:
+		return limit
+	}
+}
+
+func delegationToDelegationResponse(ctx sdk.Context, k ViewKeeper, del types.Delegation) (types.DelegationResponse, error) {
 	val, found := k.GetValidator(ctx, del.ValidatorAddress)
 	if !found {
 		return types.DelegationResponse{}, types.ErrNoValidatorFound
@@ -416,7 +577,7 @@ func delegationToDelegationResponse(ctx sdk.Context, k Keeper, del types.Delegat
 }
 
 func delegationsToDelegationResponses(
-	ctx sdk.Context, k Keeper, delegations types.Delegations,
+	ctx sdk.Context, k ViewKeeper, delegations types.Delegations,
 ) (types.DelegationResponses, error) {
 
 	resp := make(types.DelegationResponses, len(delegations))
@@ -432,8 +593,20 @@ func delegationsToDelegationResponses(
 	return resp, nil
 }
 
+func unbondingDelegationsToUnbondingDelegationResponses(
+	ubds types.UnbondingDelegations,
+) types.UnbondingDelegationResponses {
+
+	resp := make(types.UnbondingDelegationResponses, len(ubds))
+	for i, ubd := range ubds {
+		resp[i] = types.NewUnbondingDelegationResponse(ubd)
+	}
+
+	return resp
+}
+
 func redelegationsToRedelegationResponses(
-	ctx sdk.Context, k Keeper, redels types.Redelegations,
+	ctx sdk.Context, k ViewKeeper, redels types.Redelegations,
 ) (types.RedelegationResponses, error) {
 
 	resp := make(types.RedelegationResponses, len(redels))
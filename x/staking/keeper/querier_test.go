@@ -3,12 +3,16 @@ package keeper
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -332,7 +336,7 @@ func TestQueryDelegation(t *testing.T) {
 
 	// Query unbonging delegation
 	unbondingTokens := sdk.TokensFromConsensusPower(10)
-	_, err = keeper.Undelegate(ctx, addrAcc2, val1.OperatorAddress, unbondingTokens.ToDec())
+	_, _, err = keeper.Undelegate(ctx, addrAcc2, val1.OperatorAddress, unbondingTokens.ToDec())
 	require.NoError(t, err)
 
 	queryBondParams = types.NewQueryBondsParams(addrAcc2, addrVal1)
@@ -350,11 +354,15 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = queryUnbondingDelegation(ctx, query, keeper)
 	require.NoError(t, err)
 
-	var unbondRes types.UnbondingDelegation
+	var unbondRes types.UnbondingDelegationResponse
 	errRes = cdc.UnmarshalJSON(res, &unbondRes)
 	require.NoError(t, errRes)
 
-	require.Equal(t, unbond, unbondRes)
+	require.Equal(t, unbond.DelegatorAddress, unbondRes.DelegatorAddress)
+	require.Equal(t, unbond.ValidatorAddress, unbondRes.ValidatorAddress)
+	require.Len(t, unbondRes.Entries, 1)
+	require.Equal(t, unbond.Entries[0].Balance.String(), unbondRes.Entries[0].Balance.String())
+	require.True(t, unbondRes.Entries[0].SlashedAmount.IsZero())
 
 	// error unknown request
 	query.Data = bz[:len(bz)-1]
@@ -372,10 +380,10 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = queryDelegatorUnbondingDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 
-	var delegatorUbds []types.UnbondingDelegation
+	var delegatorUbds types.UnbondingDelegationResponses
 	errRes = cdc.UnmarshalJSON(res, &delegatorUbds)
 	require.NoError(t, errRes)
-	require.Equal(t, unbond, delegatorUbds[0])
+	require.Equal(t, unbondRes, delegatorUbds[0])
 
 	// error unknown request
 	query.Data = bz[:len(bz)-1]
@@ -493,7 +501,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 
 	// undelegate
 	undelAmount := sdk.TokensFromConsensusPower(20)
-	_, err = keeper.Undelegate(ctx, addrAcc1, val1.GetOperator(), undelAmount.ToDec())
+	_, _, err = keeper.Undelegate(ctx, addrAcc1, val1.GetOperator(), undelAmount.ToDec())
 	require.NoError(t, err)
 	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
@@ -513,7 +521,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	res, err := queryUnbondingDelegation(ctx, query, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	var ubDel types.UnbondingDelegation
+	var ubDel types.UnbondingDelegationResponse
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDel))
 	require.Equal(t, addrAcc1, ubDel.DelegatorAddress)
 	require.Equal(t, val1.OperatorAddress, ubDel.ValidatorAddress)
@@ -545,7 +553,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	res, err = queryDelegatorUnbondingDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	var ubDels []types.UnbondingDelegation
+	var ubDels types.UnbondingDelegationResponses
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
 	require.Equal(t, 1, len(ubDels))
 	require.Equal(t, addrAcc1, ubDels[0].DelegatorAddress)
@@ -568,6 +576,214 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	require.Equal(t, 0, len(ubDels))
 }
 
+func TestQueryUnbondingDelegationSlashed(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	// Create Validators and Delegation
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+
+	delAmount := sdk.TokensFromConsensusPower(100)
+	_, err := keeper.Delegate(ctx, addrAcc1, delAmount, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// undelegate and slash the unbonding delegation for an infraction
+	// committed after the unbonding started
+	undelAmount := sdk.TokensFromConsensusPower(20)
+	_, _, err = keeper.Undelegate(ctx, addrAcc1, val1.GetOperator(), undelAmount.ToDec())
+	require.NoError(t, err)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrAcc1, val1.OperatorAddress)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	slashed := keeper.slashUnbondingDelegation(ctx, ubd, ctx.BlockHeight()-1, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, undelAmount.QuoRaw(2), slashed)
+
+	bz, errRes := cdc.MarshalJSON(types.NewQueryBondsParams(addrAcc1, val1.GetOperator()))
+	require.NoError(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/unbondingDelegation",
+		Data: bz,
+	}
+	res, err := queryUnbondingDelegation(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var ubdRes types.UnbondingDelegationResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &ubdRes))
+	require.Len(t, ubdRes.Entries, 1)
+	require.True(t, undelAmount.Equal(ubdRes.Entries[0].InitialBalance))
+	require.True(t, slashed.Equal(ubdRes.Entries[0].SlashedAmount))
+	require.True(t, undelAmount.Sub(slashed).Equal(ubdRes.Entries[0].Balance))
+}
+
+func TestQueryDelegatorTotalStake(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	// Create Validators and Delegations
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val2)
+
+	delAmount := sdk.TokensFromConsensusPower(100)
+	_, err := keeper.Delegate(ctx, addrAcc1, delAmount, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+	_, err = keeper.Delegate(ctx, addrAcc1, delAmount, sdk.Unbonded, val2, true)
+	require.NoError(t, err)
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// undelegate everything from the second validator in two entries
+	undelAmount := sdk.TokensFromConsensusPower(50)
+	_, _, err = keeper.Undelegate(ctx, addrAcc1, val2.GetOperator(), undelAmount.ToDec())
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, _, err = keeper.Undelegate(ctx, addrAcc1, val2.GetOperator(), undelAmount.ToDec())
+	require.NoError(t, err)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrAcc1, val2.OperatorAddress)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+
+	bz, errRes := cdc.MarshalJSON(types.NewQueryDelegatorParams(addrAcc1))
+	require.NoError(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/delegatorTotalStake",
+		Data: bz,
+	}
+	res, err := queryDelegatorTotalStake(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var stake types.DelegatorStakeResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &stake))
+	require.Equal(t, addrAcc1, stake.DelegatorAddress)
+	require.Equal(t, delAmount, stake.Delegated.Amount)
+	require.Equal(t, delAmount, stake.Bonded.Amount)
+	require.Equal(t, delAmount, stake.Unbonding.Amount)
+	require.Equal(t, ubd.Entries[0].CompletionTime, stake.EarliestCompletionTime)
+
+	require.Len(t, stake.Validators, 2)
+	require.Equal(t, addrVal1, stake.Validators[0].ValidatorAddress)
+	require.Equal(t, delAmount, stake.Validators[0].Balance)
+	require.True(t, stake.Validators[0].Unbonding.IsZero())
+	require.True(t, stake.Validators[0].RewardBearing)
+	require.Equal(t, addrVal2, stake.Validators[1].ValidatorAddress)
+	require.True(t, stake.Validators[1].Balance.IsZero())
+	require.Equal(t, delAmount, stake.Validators[1].Unbonding)
+
+	// a delegator without any stake gets an empty response
+	bz, errRes = cdc.MarshalJSON(types.NewQueryDelegatorParams(addrAcc2))
+	require.NoError(t, errRes)
+	query.Data = bz
+	res, err = queryDelegatorTotalStake(ctx, query, keeper)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &stake))
+	require.True(t, stake.Delegated.IsZero())
+	require.True(t, stake.Unbonding.IsZero())
+	require.True(t, stake.EarliestCompletionTime.IsZero())
+	require.Empty(t, stake.Validators)
+}
+
+func TestQueryDelegatorMaxDelegatable(t *testing.T) {
+	cdc := codec.New()
+	ctx, ak, bk, keeper, _ := CreateTestInput(t, false, 10000)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// turn the first account into a vesting account with all of its coins locked
+	balances := bk.GetAllBalances(ctx, addrAcc1)
+	bondDenom := keeper.BondDenom(ctx)
+	acc := ak.GetAccount(ctx, addrAcc1)
+	vacc := vesting.NewContinuousVestingAccount(acc.(*auth.BaseAccount), balances, now.Unix(), now.Add(24*time.Hour).Unix())
+	ak.SetAccount(ctx, vacc)
+	require.True(t, bk.SpendableCoins(ctx, addrAcc1).IsZero())
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+
+	bz, errRes := cdc.MarshalJSON(types.NewQueryDelegatorParams(addrAcc1))
+	require.NoError(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/delegatorMaxDelegatable",
+		Data: bz,
+	}
+
+	res, err := queryDelegatorMaxDelegatable(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var delegatable sdk.Coin
+	require.NoError(t, cdc.UnmarshalJSON(res, &delegatable))
+	require.Equal(t, bondDenom, delegatable.Denom)
+	require.True(t, balances.AmountOf(bondDenom).Equal(delegatable.Amount))
+
+	// the locked coins can be delegated
+	delAmount := sdk.TokensFromConsensusPower(100)
+	_, err = keeper.Delegate(ctx, addrAcc1, delAmount, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	res, err = queryDelegatorMaxDelegatable(ctx, query, keeper)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &delegatable))
+	require.True(t, balances.AmountOf(bondDenom).Sub(delAmount).Equal(delegatable.Amount))
+
+	// delegating more than the maximum delegatable amount fails
+	val1, _ = keeper.GetValidator(ctx, addrVal1)
+	_, err = keeper.Delegate(ctx, addrAcc1, delegatable.Amount.AddRaw(1), sdk.Unbonded, val1, true)
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err))
+}
+
+func TestQueryRedelegationBudget(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	params := keeper.GetParams(ctx)
+	params.MaxRedelegationSharesPerValidator = sdk.NewDec(100)
+	keeper.SetParams(ctx, params)
+
+	// create the validators
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val2)
+
+	delAmount := sdk.TokensFromConsensusPower(100)
+	_, err := keeper.Delegate(ctx, addrAcc2, delAmount, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	_, err = keeper.BeginRedelegation(ctx, addrAcc2, val1.OperatorAddress, val2.OperatorAddress, sdk.NewDec(40))
+	require.NoError(t, err)
+
+	bz, errRes := cdc.MarshalJSON(types.NewQueryBondsParams(addrAcc2, val1.OperatorAddress))
+	require.NoError(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/redelegationBudget",
+		Data: bz,
+	}
+
+	res, err := queryRedelegationBudget(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var budget types.RedelegationBudget
+	require.NoError(t, cdc.UnmarshalJSON(res, &budget))
+	require.Equal(t, addrAcc2, budget.DelegatorAddress)
+	require.Equal(t, val1.OperatorAddress, budget.ValidatorSrcAddress)
+	require.True(t, budget.Limited)
+	require.Equal(t, sdk.NewDec(100), budget.Limit)
+	require.Equal(t, sdk.NewDec(40), budget.Redelegating)
+	require.Equal(t, sdk.NewDec(60), budget.Remaining)
+
+	// error unknown request
+	query.Data = bz[:len(bz)-1]
+
+	_, err = queryRedelegationBudget(ctx, query, keeper)
+	require.Error(t, err)
+}
+
 func TestQueryHistoricalInfo(t *testing.T) {
 	cdc := codec.New()
 	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
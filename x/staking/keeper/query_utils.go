@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"bytes"
+	"sort"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -119,3 +123,69 @@ func (k Keeper) GetAllRedelegations(
 
 	return redelegations
 }
+
+// GetDelegatorTotalStake returns the aggregated delegated, bonded and unbonding
+// stake of a delegator along with a per-validator breakdown, ordered by
+// validator operator address.
+func (k Keeper) GetDelegatorTotalStake(ctx sdk.Context, delegator sdk.AccAddress) types.DelegatorStakeResponse {
+	bondDenom := k.BondDenom(ctx)
+
+	delegated, bonded, unbonding := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+	var earliest time.Time
+
+	stakes := []types.ValidatorStake{}
+	indexes := make(map[string]int)
+
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, delegator) {
+		validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			panic(types.ErrNoValidatorFound)
+		}
+
+		balance := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		delegated = delegated.Add(balance)
+		if validator.IsBonded() {
+			bonded = bonded.Add(balance)
+		}
+
+		indexes[delegation.ValidatorAddress.String()] = len(stakes)
+		stakes = append(stakes, types.NewValidatorStake(
+			delegation.ValidatorAddress, delegation.Shares, balance, sdk.ZeroInt(), validator.IsBonded(),
+		))
+	}
+
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx, delegator) {
+		ubdBalance := sdk.ZeroInt()
+		for _, entry := range ubd.Entries {
+			ubdBalance = ubdBalance.Add(entry.Balance)
+			if earliest.IsZero() || entry.CompletionTime.Before(earliest) {
+				earliest = entry.CompletionTime
+			}
+		}
+
+		unbonding = unbonding.Add(ubdBalance)
+
+		i, ok := indexes[ubd.ValidatorAddress.String()]
+		if !ok {
+			i = len(stakes)
+			stakes = append(stakes, types.NewValidatorStake(
+				ubd.ValidatorAddress, sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt(), false,
+			))
+		}
+
+		stakes[i].Unbonding = stakes[i].Unbonding.Add(ubdBalance)
+	}
+
+	sort.Slice(stakes, func(i, j int) bool {
+		return bytes.Compare(stakes[i].ValidatorAddress, stakes[j].ValidatorAddress) < 0
+	})
+
+	return types.DelegatorStakeResponse{
+		DelegatorAddress:       delegator,
+		Delegated:              sdk.NewCoin(bondDenom, delegated),
+		Bonded:                 sdk.NewCoin(bondDenom, bonded),
+		Unbonding:              sdk.NewCoin(bondDenom, unbonding),
+		EarliestCompletionTime: earliest,
+		Validators:             stakes,
+	}
+}
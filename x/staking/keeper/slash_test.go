@@ -602,3 +602,105 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower())
 }
+
+// tests that delegations which fall below the minimum delegation by a slash
+// are unbonded
+func TestSlashDustDelegations(t *testing.T) {
+	ctx, keeper, params := setupHelper(t, 10)
+	params.MinDelegation = sdk.TokensFromConsensusPower(1)
+	keeper.SetParams(ctx, params)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	valAccAddr := sdk.AccAddress(addrVals[0])
+
+	validator := keeper.mustGetValidator(ctx, addrVals[0])
+	for _, delAddr := range []sdk.AccAddress{addrDels[0], valAccAddr} {
+		_, err := keeper.Delegate(ctx, delAddr, sdk.TokensFromConsensusPower(1), sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+		validator = keeper.mustGetValidator(ctx, addrVals[0])
+	}
+	_, err := keeper.Delegate(ctx, addrDels[1], sdk.TokensFromConsensusPower(4), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator = keeper.mustGetValidator(ctx, addrVals[0])
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(5, 1))
+
+	// the delegation which fell below the minimum has been unbonded
+	_, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(1).QuoRaw(2), ubd.Entries[0].Balance)
+
+	// the other delegations and the self-delegation are kept
+	_, found = keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	_, found = keeper.GetDelegation(ctx, valAccAddr, addrVals[0])
+	require.True(t, found)
+}
+
+// tests that the delegations to a validator without a self-delegation are not
+// unbonded by a slash, as the validator would be removed while being slashed
+func TestSlashDustDelegationsWithoutSelfDelegation(t *testing.T) {
+	ctx, keeper, params := setupHelper(t, 10)
+	params.MinDelegation = sdk.TokensFromConsensusPower(1)
+	keeper.SetParams(ctx, params)
+	consAddr := sdk.ConsAddress(PKs[3].Address())
+
+	validator := types.NewValidator(addrVals[3], PKs[3], types.Description{}).UpdateStatus(sdk.Unbonding)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	_, err := keeper.Delegate(ctx, addrDels[0], sdk.TokensFromConsensusPower(1), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator = keeper.mustGetValidator(ctx, addrVals[3])
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(5, 1))
+
+	_, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[3])
+	require.True(t, found)
+
+	// the validator can still be jailed after being slashed
+	require.NotPanics(t, func() { keeper.Jail(ctx, consAddr) })
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+}
+
+// tests that a slash only reads the delegations to the slashed validator when
+// unbonding the delegations which have become dust
+func TestSlashDustDelegationsOfValidatorOnly(t *testing.T) {
+	ctx, keeper, params := setupHelper(t, 10)
+	params.MinDelegation = sdk.TokensFromConsensusPower(1)
+	keeper.SetParams(ctx, params)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+
+	validator := keeper.mustGetValidator(ctx, addrVals[0])
+	for _, delAddr := range []sdk.AccAddress{addrDels[0], sdk.AccAddress(addrVals[0])} {
+		_, err := keeper.Delegate(ctx, delAddr, sdk.TokensFromConsensusPower(1), sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+		validator = keeper.mustGetValidator(ctx, addrVals[0])
+	}
+
+	// slashGas returns the gas consumed by slashing the validator on a branch of
+	// the state
+	slashGas := func() uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		keeper.Slash(cacheCtx, consAddr, ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(5, 1))
+
+		_, found := keeper.GetDelegation(cacheCtx, addrDels[0], addrVals[0])
+		require.False(t, found)
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+	gas := slashGas()
+
+	// delegations to other validators do not change the gas consumed
+	other := keeper.mustGetValidator(ctx, addrVals[1])
+	for _, delAddr := range addrDels[1:] {
+		_, err := keeper.Delegate(ctx, delAddr, sdk.TokensFromConsensusPower(1), sdk.Unbonded, other, true)
+		require.NoError(t, err)
+		other = keeper.mustGetValidator(ctx, addrVals[1])
+	}
+	require.Len(t, keeper.GetValidatorDelegations(ctx, addrVals[1]), len(addrDels)-1)
+	require.Equal(t, gas, slashGas())
+}
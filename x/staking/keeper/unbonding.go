@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetUnbondingID returns the last unbonding id assigned to an unbonding
+// delegation or redelegation entry.
+func (k Keeper) GetUnbondingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnbondingIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetUnbondingID sets the last unbonding id assigned to an unbonding
+// delegation or redelegation entry.
+func (k Keeper) SetUnbondingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnbondingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// IncrementUnbondingID assigns and returns a new unbonding id. Ids start at one;
+// an id of zero denotes an entry without id.
+func (k Keeper) IncrementUnbondingID(ctx sdk.Context) uint64 {
+	id := k.GetUnbondingID(ctx) + 1
+	k.SetUnbondingID(ctx, id)
+	return id
+}
+
+// set the index of an unbonding id to the key of the unbonding delegation or
+// redelegation holding the entry
+func (k Keeper) setUnbondingIndex(ctx sdk.Context, id uint64, key []byte) {
+	if id == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), key)
+}
+
+// delete the index of an unbonding id
+func (k Keeper) deleteUnbondingIndex(ctx sdk.Context, id uint64) {
+	if id == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingIndexKey(id))
+}
+
+// get the value stored under the key indexed by the unbonding id if the key
+// has the given prefix
+func (k Keeper) getByUnbondingIndex(ctx sdk.Context, id uint64, prefix []byte) []byte {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.GetUnbondingIndexKey(id))
+	if key == nil || !bytes.HasPrefix(key, prefix) {
+		return nil
+	}
+
+	return store.Get(key)
+}
+
+// GetUnbondingDelegationByUnbondingID returns the unbonding delegation holding
+// the entry with the given unbonding id.
+func (k Keeper) GetUnbondingDelegationByUnbondingID(
+	ctx sdk.Context, id uint64,
+) (ubd types.UnbondingDelegation, found bool) {
+
+	value := k.getByUnbondingIndex(ctx, id, types.UnbondingDelegationKey)
+	if value == nil {
+		return ubd, false
+	}
+
+	return types.MustUnmarshalUBD(k.cdc, value), true
+}
+
+// GetRedelegationByUnbondingID returns the redelegation holding the entry with
+// the given unbonding id.
+func (k Keeper) GetRedelegationByUnbondingID(ctx sdk.Context, id uint64) (red types.Redelegation, found bool) {
+	value := k.getByUnbondingIndex(ctx, id, types.RedelegationKey)
+	if value == nil {
+		return red, false
+	}
+
+	return types.MustUnmarshalRED(k.cdc, value), true
+}
+
+// PutUnbondingOnHold places a hold on the completion of the unbonding
+// delegation or redelegation entry with the given unbonding id. The entry is
+// neither completed nor exempt from slashing once mature until every hold is
+// released with UnbondingCanComplete.
+func (k Keeper) PutUnbondingOnHold(ctx sdk.Context, id uint64) error {
+	if ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, id); found {
+		for i := range ubd.Entries {
+			if ubd.Entries[i].UnbondingId == id {
+				ubd.Entries[i].UnbondingOnHoldRefCount++
+				k.SetUnbondingDelegation(ctx, ubd)
+				return nil
+			}
+		}
+	}
+
+	if red, found := k.GetRedelegationByUnbondingID(ctx, id); found {
+		for i := range red.Entries {
+			if red.Entries[i].UnbondingId == id {
+				red.Entries[i].UnbondingOnHoldRefCount++
+				k.SetRedelegation(ctx, red)
+				return nil
+			}
+		}
+	}
+
+	return types.ErrUnbondingNotFound
+}
+
+// UnbondingCanComplete releases a hold placed with PutUnbondingOnHold on the
+// unbonding delegation or redelegation entry with the given unbonding id. Once
+// the last hold of a mature entry is released, the entry is completed.
+func (k Keeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
+	if ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, id); found {
+		for i := range ubd.Entries {
+			if ubd.Entries[i].UnbondingId == id {
+				return k.unbondingDelegationEntryCanComplete(ctx, ubd, i)
+			}
+		}
+	}
+
+	if red, found := k.GetRedelegationByUnbondingID(ctx, id); found {
+		for i := range red.Entries {
+			if red.Entries[i].UnbondingId == id {
+				return k.redelegationEntryCanComplete(ctx, red, i)
+			}
+		}
+	}
+
+	return types.ErrUnbondingNotFound
+}
+
+func (k Keeper) unbondingDelegationEntryCanComplete(ctx sdk.Context, ubd types.UnbondingDelegation, i int) error {
+	if !ubd.Entries[i].OnHold() {
+		return types.ErrUnbondingNotOnHold
+	}
+
+	ubd.Entries[i].UnbondingOnHoldRefCount--
+	k.SetUnbondingDelegation(ctx, ubd)
+
+	// the entry has been removed from the unbonding queue once it matured
+	if ubd.Entries[i].OnHold() || !ubd.Entries[i].IsMature(ctx.HeaderTime()) {
+		return nil
+	}
+
+	balances, err := k.CompleteUnbondingWithAmount(ctx, ubd.DelegatorAddress, ubd.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, ubd.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, ubd.DelegatorAddress.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) redelegationEntryCanComplete(ctx sdk.Context, red types.Redelegation, i int) error {
+	if !red.Entries[i].OnHold() {
+		return types.ErrUnbondingNotOnHold
+	}
+
+	red.Entries[i].UnbondingOnHoldRefCount--
+	k.SetRedelegation(ctx, red)
+
+	// the entry has been removed from the redelegation queue once it matured
+	if red.Entries[i].OnHold() || !red.Entries[i].IsMature(ctx.HeaderTime()) {
+		return nil
+	}
+
+	balances, err := k.CompleteRedelegationWithAmount(
+		ctx, red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress,
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteRedelegation,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, red.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, red.ValidatorSrcAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, red.ValidatorDstAddress.String()),
+		),
+	)
+
+	return nil
+}
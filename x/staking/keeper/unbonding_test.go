@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// mockUnbondingHooks records the ids of the initiated unbondings
+type mockUnbondingHooks struct {
+	ids []uint64
+}
+
+func (h *mockUnbondingHooks) AfterUnbondingInitiated(_ sdk.Context, id uint64) {
+	h.ids = append(h.ids, id)
+}
+
+func TestUnbondingDelegationOnHold(t *testing.T) {
+	ctx, _, bk, keeper, _ := CreateTestInput(t, false, 10)
+	hooks := &mockUnbondingHooks{}
+	keeper.SetUnbondingHooks(hooks)
+
+	delBalance := bk.GetBalance(ctx, addrDels[0], keeper.BondDenom(ctx)).Amount
+	tokens := sdk.TokensFromConsensusPower(10)
+	coins := sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), tokens))
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	require.NoError(t, bk.SetBalances(ctx, notBondedPool.GetAddress(), coins))
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// the entry is mature right away
+	ubd := keeper.SetUnbondingDelegationEntry(ctx, addrDels[0], addrVals[0], 0, ctx.HeaderTime(), tokens)
+	require.Equal(t, []uint64{1}, hooks.ids)
+	require.Equal(t, uint64(1), ubd.Entries[0].UnbondingId)
+
+	resUbd, found := keeper.GetUnbondingDelegationByUnbondingID(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, ubd, resUbd)
+	_, found = keeper.GetRedelegationByUnbondingID(ctx, 1)
+	require.False(t, found)
+
+	require.Equal(t, types.ErrUnbondingNotOnHold, keeper.UnbondingCanComplete(ctx, 1))
+	require.NoError(t, keeper.PutUnbondingOnHold(ctx, 1))
+	require.NoError(t, keeper.PutUnbondingOnHold(ctx, 1))
+
+	// held entries are neither completed nor exempt from slashing
+	require.NoError(t, keeper.CompleteUnbonding(ctx, addrDels[0], addrVals[0]))
+	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, int64(2), ubd.Entries[0].UnbondingOnHoldRefCount)
+
+	slashed := keeper.slashUnbondingDelegation(ctx, ubd, 0, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, tokens.QuoRaw(2), slashed)
+
+	// the entry is completed once the last hold is released
+	require.NoError(t, keeper.UnbondingCanComplete(ctx, 1))
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delBalance, bk.GetBalance(ctx, addrDels[0], keeper.BondDenom(ctx)).Amount)
+
+	require.NoError(t, keeper.UnbondingCanComplete(ctx, 1))
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Equal(t, delBalance.Add(tokens.QuoRaw(2)), bk.GetBalance(ctx, addrDels[0], keeper.BondDenom(ctx)).Amount)
+
+	_, found = keeper.GetUnbondingDelegationByUnbondingID(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, types.ErrUnbondingNotFound, keeper.PutUnbondingOnHold(ctx, 1))
+	require.Equal(t, types.ErrUnbondingNotFound, keeper.UnbondingCanComplete(ctx, 1))
+}
+
+func TestRedelegationOnHold(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
+	hooks := &mockUnbondingHooks{}
+	keeper.SetUnbondingHooks(hooks)
+
+	// ids are shared with unbonding delegations
+	keeper.SetUnbondingID(ctx, 4)
+
+	red := keeper.SetRedelegationEntry(
+		ctx, addrDels[0], addrVals[0], addrVals[1], 0, ctx.HeaderTime(),
+		sdk.NewInt(5), sdk.NewDec(5), sdk.NewDec(5),
+	)
+	require.Equal(t, []uint64{5}, hooks.ids)
+	require.Equal(t, uint64(5), red.Entries[0].UnbondingId)
+
+	resRed, found := keeper.GetRedelegationByUnbondingID(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, red, resRed)
+	_, found = keeper.GetUnbondingDelegationByUnbondingID(ctx, 5)
+	require.False(t, found)
+
+	require.NoError(t, keeper.PutUnbondingOnHold(ctx, 5))
+	require.NoError(t, keeper.CompleteRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1]))
+	_, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.True(t, found)
+
+	require.NoError(t, keeper.UnbondingCanComplete(ctx, 5))
+	_, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.False(t, found)
+	_, found = keeper.GetRedelegationByUnbondingID(ctx, 5)
+	require.False(t, found)
+}
@@ -30,7 +30,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	k.UnbondAllMatureValidatorQueue(ctx)
 
 	// Remove all mature unbonding delegations from the ubd queue.
-	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
+	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.HeaderTime())
 	for _, dvPair := range matureUnbonds {
 		balances, err := k.CompleteUnbondingWithAmount(ctx, dvPair.DelegatorAddress, dvPair.ValidatorAddress)
 		if err != nil {
@@ -48,7 +48,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	}
 
 	// Remove all mature redelegations from the red queue.
-	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.HeaderTime())
 	for _, dvvTriplet := range matureRedelegations {
 		balances, err := k.CompleteRedelegationWithAmount(
 			ctx,
@@ -83,12 +83,17 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // It gets called once after genesis, another time maybe after genesis transactions,
 // then once at every EndBlock.
 //
+// If MaxValidators has been reduced below the size of the last validator set,
+// the validator set shrinks by at most MaxValidatorsPhaseOutRate validators per
+// block, removing the validators with the lowest power first (see
+// phaseOutMaxValidators). The validators removed to shrink the validator set,
+// rather than displaced by validators entering it, are phased out.
+//
 // CONTRACT: Only validators with non-zero power or zero-power that were bonded
 // at the previous block height or were removed from the validator set entirely
 // are returned to Tendermint.
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate) {
 
-	maxValidators := k.GetParams(ctx).MaxValidators
 	totalPower := sdk.ZeroInt()
 	amtFromBondedToNotBonded, amtFromNotBondedToBonded := sdk.ZeroInt(), sdk.ZeroInt()
 
@@ -96,11 +101,16 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// The persistent set is updated later in this function.
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
+	lastSize := len(last)
+
+	params := k.GetParams(ctx)
+	maxValidators := phaseOutMaxValidators(params.MaxValidators, params.MaxValidatorsPhaseOutRate, lastSize)
 
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
-	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
+	count := 0
+	for ; iterator.Valid() && count < int(maxValidators); iterator.Next() {
 
 		// everything that is iterated in this loop is becoming or already a
 		// part of the bonded validator set
@@ -152,6 +162,20 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		totalPower = totalPower.Add(sdk.NewInt(newPower))
 	}
 
+	// The validators following the new validator set which would have stayed
+	// bonded if the validator set did not shrink are phased out. Any validator
+	// ranked lower has been displaced by a validator entering the validator set.
+	phasedOut := make(map[string]bool)
+	for n := count; iterator.Valid() && n < lastSize; iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Value())
+		if k.mustGetValidator(ctx, valAddr).PotentialConsensusPower() == 0 {
+			break
+		}
+
+		phasedOut[string(valAddr)] = true
+		n++
+	}
+
 	noLongerBonded := sortNoLongerBonded(last)
 	for _, valAddrBytes := range noLongerBonded {
 
@@ -160,6 +184,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		if phasedOut[string(valAddrBytes)] {
+			k.AfterValidatorPhasedOut(ctx, validator.GetConsAddr(), validator.OperatorAddress)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePhaseOutValidator,
+					sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyMaxValidators, fmt.Sprintf("%d", maxValidators)),
+				),
+			)
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -183,9 +219,26 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		k.SetLastTotalPower(ctx, totalPower)
 	}
 
+	if count != lastSize {
+		k.SetLastValidatorSetSize(ctx, uint32(count))
+	}
+
 	return updates
 }
 
+// phaseOutMaxValidators returns the maximum number of bonded validators of the
+// current block. It is the MaxValidators parameter, unless the parameter has
+// been reduced by more than the phase out rate below the size of the last
+// validator set, in which case the validator set is reduced by only rate
+// validators. A zero rate reduces the validator set at once.
+func phaseOutMaxValidators(maxValidators, rate uint32, lastValidators int) uint32 {
+	if rate == 0 || lastValidators <= int(maxValidators)+int(rate) {
+		return maxValidators
+	}
+
+	return uint32(lastValidators) - rate
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) types.Validator {
@@ -274,7 +327,7 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	validator = validator.UpdateStatus(sdk.Unbonding)
 
 	// set the unbonding completion time and completion height appropriately
-	validator.UnbondingTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
+	validator.UnbondingTime = ctx.HeaderTime().Add(params.UnbondingTime)
 	validator.UnbondingHeight = ctx.BlockHeader().Height
 
 	// save the now unbonded validator record and power index
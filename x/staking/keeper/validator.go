@@ -160,7 +160,7 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	validator types.Validator, newRate sdk.Dec) (types.Commission, error) {
 
 	commission := validator.Commission
-	blockTime := ctx.BlockHeader().Time
+	blockTime := ctx.HeaderTime()
 
 	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
 		return commission, err
@@ -240,7 +240,7 @@ func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators [
 
 // get the current group of bonded validators sorted by power-rank
 func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
-	maxValidators := k.MaxValidators(ctx)
+	maxValidators := k.maxBondedValidators(ctx)
 	validators := make([]types.Validator, maxValidators)
 
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
@@ -318,12 +318,24 @@ func (k Keeper) IterateLastValidatorPowers(ctx sdk.Context, handler func(operato
 	}
 }
 
+// maxBondedValidators returns the maximum number of bonded validators. It
+// exceeds MaxValidators while the validator set is shrunk after MaxValidators
+// has been reduced.
+func (k Keeper) maxBondedValidators(ctx sdk.Context) uint32 {
+	maxValidators := k.MaxValidators(ctx)
+	if size := k.GetLastValidatorSetSize(ctx); size > maxValidators {
+		return size
+	}
+
+	return maxValidators
+}
+
 // get the group of the bonded validators
 func (k Keeper) GetLastValidators(ctx sdk.Context) (validators []types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 
 	// add the actual validator power sorted store
-	maxValidators := k.MaxValidators(ctx)
+	maxValidators := k.maxBondedValidators(ctx)
 	validators = make([]types.Validator, maxValidators)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.LastValidatorPowerKey)
@@ -408,7 +420,7 @@ func (k Keeper) ValidatorQueueIterator(ctx sdk.Context, endTime time.Time) sdk.I
 // Returns a concatenated list of all the timeslices before currTime, and deletes the timeslices from the queue
 func (k Keeper) GetAllMatureValidatorQueue(ctx sdk.Context, currTime time.Time) (matureValsAddrs []sdk.ValAddress) {
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	validatorTimesliceIterator := k.ValidatorQueueIterator(ctx, ctx.BlockHeader().Time)
+	validatorTimesliceIterator := k.ValidatorQueueIterator(ctx, ctx.HeaderTime())
 	defer validatorTimesliceIterator.Close()
 
 	for ; validatorTimesliceIterator.Valid(); validatorTimesliceIterator.Next() {
@@ -424,7 +436,7 @@ func (k Keeper) GetAllMatureValidatorQueue(ctx sdk.Context, currTime time.Time)
 // Unbonds all the unbonding validators that have finished their unbonding period
 func (k Keeper) UnbondAllMatureValidatorQueue(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	validatorTimesliceIterator := k.ValidatorQueueIterator(ctx, ctx.BlockHeader().Time)
+	validatorTimesliceIterator := k.ValidatorQueueIterator(ctx, ctx.HeaderTime())
 	defer validatorTimesliceIterator.Close()
 
 	for ; validatorTimesliceIterator.Valid(); validatorTimesliceIterator.Next() {
@@ -1091,3 +1091,94 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyAndReturnValidatorSetUpdatesPhaseOut(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	powers := []int64{100, 200, 300, 400, 500, 600}
+	var validators [6]types.Validator
+	for i, power := range powers {
+		valPubKey := PKs[i+1]
+		validators[i] = types.NewValidator(sdk.ValAddress(valPubKey.Address().Bytes()), valPubKey, types.Description{})
+		validators[i], _ = validators[i].AddTokensFromDel(sdk.TokensFromConsensusPower(power))
+		if i < 5 {
+			keeper.SetValidator(ctx, validators[i])
+			keeper.SetValidatorByPowerIndex(ctx, validators[i])
+		}
+	}
+
+	require.Equal(t, 5, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+	require.Equal(t, uint32(5), keeper.GetLastValidatorSetSize(ctx))
+
+	params := keeper.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxValidatorsPhaseOutRate, params.MaxValidatorsPhaseOutRate)
+	params.MaxValidators = uint32(2)
+	keeper.SetParams(ctx, params)
+
+	requireStatus := func(validator types.Validator, status sdk.BondStatus) {
+		validator, found := keeper.GetValidator(ctx, validator.OperatorAddress)
+		require.True(t, found)
+		require.Equal(t, status, validator.Status)
+	}
+	requirePhasedOut := func(maxValidators int, validators ...types.Validator) {
+		var events sdk.Events
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypePhaseOutValidator {
+				events = append(events, event)
+			}
+		}
+
+		require.Len(t, events, len(validators))
+		for i, validator := range validators {
+			require.Equal(t, validator.OperatorAddress.String(), string(events[i].Attributes[0].Value))
+			require.Equal(t, fmt.Sprintf("%d", maxValidators), string(events[i].Attributes[1].Value))
+		}
+	}
+
+	// the validator with the lowest power is removed
+	ctx = ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, []abci.ValidatorUpdate{validators[0].ABCIValidatorUpdateZero()}, updates)
+	requireStatus(validators[0], sdk.Unbonding)
+	requirePhasedOut(4, validators[0])
+	require.Len(t, keeper.GetLastValidators(ctx), 4)
+	require.Len(t, keeper.GetBondedValidatorsByPower(ctx), 4)
+
+	// a validator displaced by a new validator is not phased out
+	keeper.SetValidator(ctx, validators[5])
+	keeper.SetValidatorByPowerIndex(ctx, validators[5])
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 3, len(updates))
+	validator, found := keeper.GetValidator(ctx, validators[5].OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
+	requireStatus(validators[1], sdk.Unbonding)
+	requireStatus(validators[2], sdk.Unbonding)
+	requirePhasedOut(3, validators[2])
+	require.Len(t, keeper.GetLastValidators(ctx), 3)
+
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, []abci.ValidatorUpdate{validators[3].ABCIValidatorUpdateZero()}, updates)
+	requirePhasedOut(2, validators[3])
+	require.Equal(t, uint32(2), keeper.GetLastValidatorSetSize(ctx))
+
+	// no more validators are removed once the validator set is small enough
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+	requirePhasedOut(2)
+	requireStatus(validators[4], sdk.Bonded)
+	requireStatus(validators[5], sdk.Bonded)
+
+	// a zero phase out rate removes the validators at once
+	params.MaxValidators = uint32(1)
+	params.MaxValidatorsPhaseOutRate = 0
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	require.Equal(t, []abci.ValidatorUpdate{validators[4].ABCIValidatorUpdateZero()}, keeper.ApplyAndReturnValidatorSetUpdates(ctx))
+	requirePhasedOut(1, validators[4])
+	require.Equal(t, uint32(1), keeper.GetLastValidatorSetSize(ctx))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+var _ ViewKeeper = Keeper{}
+
+// ViewKeeper defines a read only view of the staking keeper. It is used by the
+// staking querier and can be passed to modules which only need to read the
+// staking state, so that any write through it fails to compile.
+type ViewKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	BondDenom(ctx sdk.Context) string
+	UnbondingTime(ctx sdk.Context) time.Duration
+	MaxValidators(ctx sdk.Context) uint32
+	MaxEntries(ctx sdk.Context) uint32
+	HistoricalEntries(ctx sdk.Context) uint32
+
+	GetBondedPool(ctx sdk.Context) supplyexported.ModuleAccountI
+	GetNotBondedPool(ctx sdk.Context) supplyexported.ModuleAccountI
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+	NotBondedTokens(ctx sdk.Context) sdk.Int
+	StakingTokenSupply(ctx sdk.Context) sdk.Int
+	BondedRatio(ctx sdk.Context) sdk.Dec
+
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (types.Validator, bool)
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (types.Validator, bool)
+	GetAllValidators(ctx sdk.Context) []types.Validator
+	GetValidators(ctx sdk.Context, maxRetrieve uint32) []types.Validator
+	GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator
+	GetLastValidators(ctx sdk.Context) []types.Validator
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
+	GetLastTotalPower(ctx sdk.Context) sdk.Int
+	GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool)
+
+	Validator(ctx sdk.Context, address sdk.ValAddress) exported.ValidatorI
+	ValidatorByConsAddr(ctx sdk.Context, addr sdk.ConsAddress) exported.ValidatorI
+	IterateValidators(ctx sdk.Context, fn func(index int64, validator exported.ValidatorI) (stop bool))
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator exported.ValidatorI) (stop bool))
+	IterateLastValidators(ctx sdk.Context, fn func(index int64, validator exported.ValidatorI) (stop bool))
+
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (types.Delegation, bool)
+	GetAllDelegations(ctx sdk.Context) []types.Delegation
+	GetAllSDKDelegations(ctx sdk.Context) []types.Delegation
+	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []types.Delegation
+	GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []types.Delegation
+	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) []types.Delegation
+	GetDelegationsPaginated(ctx sdk.Context, key []byte, limit int) ([]types.Delegation, []byte)
+	GetDelegatorValidators(ctx sdk.Context, delegatorAddr sdk.AccAddress, maxRetrieve uint32) []types.Validator
+	GetDelegatorValidator(ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (types.Validator, error)
+	GetDelegatorTotalStake(ctx sdk.Context, delegator sdk.AccAddress) types.DelegatorStakeResponse
+	GetMaxDelegatable(ctx sdk.Context, delAddr sdk.AccAddress) sdk.Coin
+
+	Delegation(ctx sdk.Context, addrDel sdk.AccAddress, addrVal sdk.ValAddress) exported.DelegationI
+	IterateAllDelegations(ctx sdk.Context, cb func(delegation types.Delegation) (stop bool))
+	IterateDelegations(ctx sdk.Context, delAddr sdk.AccAddress, fn func(index int64, del exported.DelegationI) (stop bool))
+
+	GetUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (types.UnbondingDelegation, bool)
+	GetUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []types.UnbondingDelegation
+	GetAllUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress) []types.UnbondingDelegation
+	GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) []types.UnbondingDelegation
+	GetUnbondingDelegationsPaginated(ctx sdk.Context, key []byte, limit int) ([]types.UnbondingDelegation, []byte)
+
+	GetRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) (types.Redelegation, bool)
+	GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []types.Redelegation
+	GetAllRedelegations(ctx sdk.Context, delegator sdk.AccAddress, srcValAddress, dstValAddress sdk.ValAddress) []types.Redelegation
+	GetRedelegationsFromSrcValidator(ctx sdk.Context, valAddr sdk.ValAddress) []types.Redelegation
+	GetRedelegationsPaginated(ctx sdk.Context, key []byte, limit int) ([]types.Redelegation, []byte)
+	GetRedelegationBudget(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress) types.RedelegationBudget
+}
@@ -28,6 +28,8 @@ import (
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.HasIndexedEvents    = AppModuleBasic{}
+	_ module.HasStoreKeyDecoder  = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 )
 
@@ -77,6 +79,23 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(StoreKey, cdc)
 }
 
+// IndexedEvents returns the event attributes of the staking module to index.
+func (AppModuleBasic) IndexedEvents() []string {
+	return []string{
+		fmt.Sprintf("%s.%s", types.EventTypeCreateValidator, types.AttributeKeyValidator),
+		fmt.Sprintf("%s.%s", types.EventTypeDelegate, types.AttributeKeyValidator),
+		fmt.Sprintf("%s.%s", types.EventTypeUnbond, types.AttributeKeyValidator),
+		fmt.Sprintf("%s.%s", types.EventTypeRedelegate, types.AttributeKeySrcValidator),
+		fmt.Sprintf("%s.%s", types.EventTypeRedelegate, types.AttributeKeyDstValidator),
+	}
+}
+
+// DecodeStoreKey returns a human readable representation of a raw staking
+// store key.
+func (AppModuleBasic) DecodeStoreKey(key []byte) (string, error) {
+	return types.DecodeStoreKey(key)
+}
+
 //_____________________________________
 // extra helpers
 
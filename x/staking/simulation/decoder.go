@@ -2,8 +2,10 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,6 +22,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &powerB)
 		return fmt.Sprintf("%v\n%v", powerA, powerB)
 
+	case bytes.Equal(kvA.Key[:1], types.LastValidatorSetSizeKey):
+		var sizeA, sizeB gogotypes.UInt32Value
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &sizeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &sizeB)
+		return fmt.Sprintf("%v\n%v", sizeA.Value, sizeB.Value)
+
 	case bytes.Equal(kvA.Key[:1], types.ValidatorsKey):
 		var validatorA, validatorB types.Validator
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &validatorA)
@@ -51,6 +59,13 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.UnbondingIDKey):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.UnbondingIndexKey),
+		bytes.Equal(kvA.Key[:1], types.DelegationByValIndexKey):
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -41,11 +42,14 @@ func TestDecodeStore(t *testing.T) {
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.LastTotalPowerKey, Value: cdc.MustMarshalBinaryLengthPrefixed(sdk.OneInt())},
+		tmkv.Pair{Key: types.LastValidatorSetSizeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(gogotypes.UInt32Value{Value: 3})},
 		tmkv.Pair{Key: types.GetValidatorKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(val)},
 		tmkv.Pair{Key: types.LastValidatorPowerKey, Value: valAddr1.Bytes()},
 		tmkv.Pair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(del)},
 		tmkv.Pair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(ubd)},
 		tmkv.Pair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(red)},
+		tmkv.Pair{Key: types.UnbondingIDKey, Value: sdk.Uint64ToBigEndian(7)},
+		tmkv.Pair{Key: types.GetUnbondingIndexKey(7), Value: types.GetUBDKey(delAddr1, valAddr1)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -54,11 +58,14 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"LastTotalPower", fmt.Sprintf("%v\n%v", sdk.OneInt(), sdk.OneInt())},
+		{"LastValidatorSetSize", "3\n3"},
 		{"Validator", fmt.Sprintf("%v\n%v", val, val)},
 		{"LastValidatorPower/ValidatorsByConsAddr/ValidatorsByPowerIndex", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"UnbondingID", "7\n7"},
+		{"UnbondingIndex", fmt.Sprintf("%X\n%X", types.GetUBDKey(delAddr1, valAddr1), types.GetUBDKey(delAddr1, valAddr1))},
 		{"other", ""},
 	}
 	for i, tt := range tests {
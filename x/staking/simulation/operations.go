@@ -21,6 +21,7 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgEditDelegation  = "op_weight_msg_edit_delegation"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -35,6 +36,7 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+		weightMsgEditDelegation  int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +69,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgEditDelegation, &weightMsgEditDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgEditDelegation = simappparams.DefaultWeightMsgEditDelegation
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +96,10 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgEditDelegation,
+			SimulateMsgEditDelegation(ak, bk, k),
+		),
 	}
 }
 
@@ -190,7 +202,7 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 
 		newCommissionRate := simulation.RandomDecAmount(r, val.Commission.MaxRate)
 
-		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.BlockHeader().Time); err != nil {
+		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.HeaderTime()); err != nil {
 			// skip as the commission is invalid
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -493,3 +505,78 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgEditDelegation generates a MsgEditDelegation with random values
+// nolint: interfacer
+func SimulateMsgEditDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		valAddr := validator.GetOperator()
+
+		delegations := k.GetValidatorDelegations(ctx, validator.OperatorAddress)
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if delAddr.Equals(valAddr) ||
+			k.HasReceivingRedelegation(ctx, delAddr, valAddr) ||
+			!bk.LockedCoins(ctx, delAddr).IsZero() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		newDelAccount, _ := simulation.RandomAcc(r, accs)
+		if newDelAccount.Address.Equals(delAddr) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simulation.Account
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		newDelegator := ak.GetAccount(ctx, newDelAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgEditDelegation(delAddr, valAddr, newDelAccount.Address)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber(), newDelegator.GetAccountNumber()},
+			[]uint64{account.GetSequence(), newDelegator.GetSequence()},
+			simAccount.PrivKey, newDelAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -98,6 +98,11 @@ func (e UnbondingDelegationEntry) IsMature(currentTime time.Time) bool {
 	return !e.CompletionTime.After(currentTime)
 }
 
+// OnHold - is the completion of the entry on hold
+func (e UnbondingDelegationEntry) OnHold() bool {
+	return e.UnbondingOnHoldRefCount > 0
+}
+
 // NewUnbondingDelegation - create a new unbonding delegation object
 func NewUnbondingDelegation(
 	delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
@@ -190,6 +195,11 @@ func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
 	return !e.CompletionTime.After(currentTime)
 }
 
+// OnHold - is the completion of the entry on hold
+func (e RedelegationEntry) OnHold() bool {
+	return e.UnbondingOnHoldRefCount > 0
+}
+
 func NewRedelegation(
 	delegatorAddr sdk.AccAddress, validatorSrcAddr, validatorDstAddr sdk.ValAddress,
 	creationHeight int64, minTime time.Time, balance sdk.Int, sharesDst sdk.Dec,
@@ -320,6 +330,101 @@ func (d DelegationResponses) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// UnbondingDelegationResponse is equivalent to an UnbondingDelegation except
+// that its entries contain the amount slashed since the unbonding started
+// which is more suitable for client responses.
+type UnbondingDelegationResponse struct {
+	UnbondingDelegation
+	Entries []UnbondingDelegationEntryResponse `json:"entries" yaml:"entries"`
+}
+
+// NewUnbondingDelegationResponse creates a new UnbondingDelegationResponse
+// instance from an UnbondingDelegation.
+func NewUnbondingDelegationResponse(ubd UnbondingDelegation) UnbondingDelegationResponse {
+	entries := make([]UnbondingDelegationEntryResponse, len(ubd.Entries))
+	for i, entry := range ubd.Entries {
+		entries[i] = NewUnbondingDelegationEntryResponse(entry)
+	}
+
+	return UnbondingDelegationResponse{
+		UnbondingDelegation: UnbondingDelegation{
+			DelegatorAddress: ubd.DelegatorAddress,
+			ValidatorAddress: ubd.ValidatorAddress,
+		},
+		Entries: entries,
+	}
+}
+
+// UnbondingDelegationEntryResponse is equivalent to an UnbondingDelegationEntry
+// except that it contains the amount slashed from the entry since it was
+// created. The Balance of the entry is the amount that will be released on
+// completion.
+type UnbondingDelegationEntryResponse struct {
+	UnbondingDelegationEntry
+	SlashedAmount sdk.Int `json:"slashed_amount" yaml:"slashed_amount"`
+}
+
+// NewUnbondingDelegationEntryResponse creates a new
+// UnbondingDelegationEntryResponse instance. Slashes for infractions committed
+// at or after the entry's creation height are applied to its balance as they
+// occur, so the slashed amount is the difference to the initial balance.
+func NewUnbondingDelegationEntryResponse(entry UnbondingDelegationEntry) UnbondingDelegationEntryResponse {
+	return UnbondingDelegationEntryResponse{
+		UnbondingDelegationEntry: entry,
+		SlashedAmount:            entry.InitialBalance.Sub(entry.Balance),
+	}
+}
+
+// String implements the Stringer interface for UnbondingDelegationResponse.
+func (ubd UnbondingDelegationResponse) String() string {
+	out := fmt.Sprintf(`Unbonding Delegations between:
+  Delegator:                 %s
+  Validator:                 %s
+  Entries:
+`,
+		ubd.DelegatorAddress, ubd.ValidatorAddress,
+	)
+
+	for i, entry := range ubd.Entries {
+		out += fmt.Sprintf(`    Unbonding Delegation %d:
+      Creation Height:           %v
+      Min time to unbond (unix): %v
+      Initial Balance:           %s
+      Slashed Amount:            %s
+      Expected balance:          %s
+`,
+			i, entry.CreationHeight, entry.CompletionTime, entry.InitialBalance, entry.SlashedAmount, entry.Balance,
+		)
+	}
+
+	return strings.TrimRight(out, "\n")
+}
+
+type unbondingDelegationRespAlias UnbondingDelegationResponse
+
+// MarshalJSON implements the json.Marshaler interface. This is so we can
+// achieve a flattened structure while embedding other types.
+func (ubd UnbondingDelegationResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal((unbondingDelegationRespAlias)(ubd))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. This is so we can
+// achieve a flattened structure while embedding other types.
+func (ubd *UnbondingDelegationResponse) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, (*unbondingDelegationRespAlias)(ubd))
+}
+
+// UnbondingDelegationResponses is a collection of UnbondingDelegationResponse
+type UnbondingDelegationResponses []UnbondingDelegationResponse
+
+// String implements the Stringer interface for UnbondingDelegationResponses.
+func (ubds UnbondingDelegationResponses) String() (out string) {
+	for _, ubd := range ubds {
+		out += ubd.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
 // RedelegationResponse is equivalent to a Redelegation except that its entries
 // contain a balance in addition to shares which is more suitable for client
 // responses.
@@ -408,3 +513,97 @@ func (r RedelegationResponses) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// ValidatorStake is a single validator's share of a DelegatorStakeResponse. It
+// contains the delegator's bonded and unbonding tokens with that validator.
+type ValidatorStake struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Shares           sdk.Dec        `json:"shares" yaml:"shares"`
+	Balance          sdk.Int        `json:"balance" yaml:"balance"`
+	Unbonding        sdk.Int        `json:"unbonding" yaml:"unbonding"`
+	RewardBearing    bool           `json:"reward_bearing" yaml:"reward_bearing"`
+}
+
+// NewValidatorStake creates a new ValidatorStake instance.
+func NewValidatorStake(valAddr sdk.ValAddress, shares sdk.Dec, balance, unbonding sdk.Int, rewardBearing bool) ValidatorStake {
+	return ValidatorStake{
+		ValidatorAddress: valAddr,
+		Shares:           shares,
+		Balance:          balance,
+		Unbonding:        unbonding,
+		RewardBearing:    rewardBearing,
+	}
+}
+
+// String implements the Stringer interface for ValidatorStake.
+func (vs ValidatorStake) String() string {
+	return fmt.Sprintf(`Validator: %s
+  Shares:         %s
+  Balance:        %s
+  Unbonding:      %s
+  Reward Bearing: %v`,
+		vs.ValidatorAddress, vs.Shares, vs.Balance, vs.Unbonding, vs.RewardBearing,
+	)
+}
+
+// DelegatorStakeResponse aggregates all the stake of a single delegator so that
+// clients do not have to combine the delegation and unbonding delegation
+// queries themselves. Delegated is the total balance of all delegations, of
+// which Bonded is the part delegated to bonded, and thus reward-bearing,
+// validators. EarliestCompletionTime is the zero time when there are no
+// unbonding delegation entries.
+type DelegatorStakeResponse struct {
+	DelegatorAddress       sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
+	Delegated              sdk.Coin         `json:"delegated" yaml:"delegated"`
+	Bonded                 sdk.Coin         `json:"bonded" yaml:"bonded"`
+	Unbonding              sdk.Coin         `json:"unbonding" yaml:"unbonding"`
+	EarliestCompletionTime time.Time        `json:"earliest_completion_time" yaml:"earliest_completion_time"`
+	Validators             []ValidatorStake `json:"validators" yaml:"validators"`
+}
+
+// String implements the Stringer interface for DelegatorStakeResponse.
+func (d DelegatorStakeResponse) String() string {
+	out := fmt.Sprintf(`Stake of delegator %s:
+  Delegated:                %s
+  Bonded:                   %s
+  Unbonding:                %s
+  Earliest Completion Time: %v
+  Validators:
+`,
+		d.DelegatorAddress, d.Delegated, d.Bonded, d.Unbonding, d.EarliestCompletionTime,
+	)
+
+	for _, vs := range d.Validators {
+		out += "    " + strings.ReplaceAll(vs.String(), "\n", "\n    ") + "\n"
+	}
+
+	return strings.TrimRight(out, "\n")
+}
+
+// RedelegationBudget reports the destination shares a delegator may still
+// redelegate from a source validator before the
+// MaxRedelegationSharesPerValidator parameter is reached. Redelegating is the
+// total destination shares of the immature redelegation entries from the source
+// validator, to any destination validators. If the parameter is not set,
+// Limited is false and Limit and Remaining are zero.
+type RedelegationBudget struct {
+	DelegatorAddress    sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress `json:"validator_src_address" yaml:"validator_src_address"`
+	Limited             bool           `json:"limited" yaml:"limited"`
+	Limit               sdk.Dec        `json:"limit" yaml:"limit"`
+	Redelegating        sdk.Dec        `json:"redelegating" yaml:"redelegating"`
+	Remaining           sdk.Dec        `json:"remaining" yaml:"remaining"`
+}
+
+// String implements the Stringer interface for RedelegationBudget.
+func (b RedelegationBudget) String() string {
+	return fmt.Sprintf(`Redelegation budget of delegator %s:
+  Source Validator: %s
+  Limited:          %v
+  Limit:            %s
+  Redelegating:     %s
+  Remaining:        %s`,
+		b.DelegatorAddress, b.ValidatorSrcAddress,
+		b.Limited, b.Limit, b.Redelegating, b.Remaining,
+	)
+}
@@ -57,4 +57,11 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 44, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 45, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 46, "empty validator public key")
+	ErrMaxRedelegationShares           = sdkerrors.Register(ModuleName, 47, "too many redelegation shares for (delegator, src-validator, dst-validator) tuple")
+	ErrTransferToSameDelegator         = sdkerrors.Register(ModuleName, 48, "cannot transfer a delegation to the same delegator")
+	ErrTransferSelfDelegation          = sdkerrors.Register(ModuleName, 49, "cannot transfer the self-delegation of a validator operator")
+	ErrTransferReceivingRedelegation   = sdkerrors.Register(ModuleName, 50, "cannot transfer a delegation receiving an immature redelegation")
+	ErrTransferLockedCoins             = sdkerrors.Register(ModuleName, 51, "cannot transfer a delegation of an account with locked coins")
+	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 52, "no unbonding delegation or redelegation entry found for unbonding id")
+	ErrUnbondingNotOnHold              = sdkerrors.Register(ModuleName, 53, "unbonding delegation or redelegation entry is not on hold")
 )
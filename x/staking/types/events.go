@@ -9,6 +9,8 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeEditDelegation       = "edit_delegation"
+	EventTypePhaseOutValidator    = "phase_out_validator"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -16,6 +18,8 @@ const (
 	AttributeKeySrcValidator      = "source_validator"
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
+	AttributeKeyNewDelegator      = "new_delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyMaxValidators     = "max_validators"
 	AttributeValueCategory        = ModuleName
 )
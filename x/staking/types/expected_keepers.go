@@ -102,6 +102,7 @@ type StakingHooks interface {
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)         // Must be called when a validator is bonded
 	AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator begins unbonding
+	AfterValidatorPhasedOut(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)      // Must be called when a validator is removed from a shrinking validator set
 
 	BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)        // Must be called when a delegation is created
 	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) // Must be called when a delegation's shares are modified
@@ -109,3 +110,11 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
+
+// UnbondingHooks event hooks for unbonding delegation and redelegation entries
+// (noalias). They allow other modules to defer the completion of an entry, and
+// keep it slashable, by placing holds on it via PutUnbondingOnHold until they
+// are released with UnbondingCanComplete.
+type UnbondingHooks interface {
+	AfterUnbondingInitiated(ctx sdk.Context, id uint64) // Must be called when an unbonding delegation or redelegation entry is created
+}
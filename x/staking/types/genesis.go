@@ -4,7 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GenesisState - all staking state that must be provided at genesis
+// GenesisState - all staking state that must be provided at genesis. UnbondingID
+// is the last unbonding id assigned to an unbonding delegation or redelegation
+// entry, and must not be below the id of any of the entries.
 type GenesisState struct {
 	Params               Params                `json:"params" yaml:"params"`
 	LastTotalPower       sdk.Int               `json:"last_total_power" yaml:"last_total_power"`
@@ -13,6 +15,7 @@ type GenesisState struct {
 	Delegations          Delegations           `json:"delegations" yaml:"delegations"`
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
+	UnbondingID          uint64                `json:"unbonding_id" yaml:"unbonding_id"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
 }
 
@@ -32,6 +32,11 @@ func (h MultiStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.Co
 		h[i].AfterValidatorBonded(ctx, consAddr, valAddr)
 	}
 }
+func (h MultiStakingHooks) AfterValidatorPhasedOut(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterValidatorPhasedOut(ctx, consAddr, valAddr)
+	}
+}
 func (h MultiStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	for i := range h {
 		h[i].AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
@@ -62,3 +67,17 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// combine multiple unbonding hooks, all hook functions are run in array sequence
+type MultiUnbondingHooks []UnbondingHooks
+
+func NewMultiUnbondingHooks(hooks ...UnbondingHooks) MultiUnbondingHooks {
+	return hooks
+}
+
+// nolint
+func (h MultiUnbondingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) {
+	for i := range h {
+		h[i].AfterUnbondingInitiated(ctx, id)
+	}
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"encoding/binary"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -32,6 +34,8 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
+	LastValidatorSetSizeKey = []byte{0x13} // key for the size of the last validator set
+
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
@@ -43,6 +47,11 @@ var (
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
 
+	UnbondingIDKey    = []byte{0x37} // key for the counter of unbonding ids
+	UnbondingIndexKey = []byte{0x38} // prefix for each key to an unbonding-delegation or redelegation, by unbonding id
+
+	DelegationByValIndexKey = []byte{0x39} // prefix for each key for a delegation, by validator operator
+
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
@@ -138,6 +147,28 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, delAddr.Bytes()...)
 }
 
+// gets the index-key for a delegation, stored by validator-index
+// VALUE: none (key rearrangement used)
+func GetDelegationByValIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationsByValIndexKey(valAddr), delAddr.Bytes()...)
+}
+
+// rearranges the ValIndexKey to get the DelegationKey
+func GetDelegationKeyFromValIndexKey(indexKey []byte) []byte {
+	addrs := indexKey[1:] // remove prefix bytes
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr := addrs[:sdk.AddrLen]
+	delAddr := addrs[sdk.AddrLen:]
+	return GetDelegationKey(delAddr, valAddr)
+}
+
+// gets the prefix keyspace for the indexes of delegations to a validator
+func GetDelegationsByValIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationByValIndexKey, valAddr.Bytes()...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for an unbonding delegation by delegator and validator addr
@@ -264,6 +295,11 @@ func GetREDsKey(delAddr sdk.AccAddress) []byte {
 	return append(RedelegationKey, delAddr.Bytes()...)
 }
 
+// gets the prefix keyspace for the redelegations of a delegator away from a source validator
+func GetREDsByDelFromValSrcKey(delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress) []byte {
+	return append(GetREDsKey(delAddr), valSrcAddr.Bytes()...)
+}
+
 // gets the prefix keyspace for all redelegations redelegating away from a source validator
 func GetREDsFromValSrcIndexKey(valSrcAddr sdk.ValAddress) []byte {
 	return append(RedelegationByValSrcIndexKey, valSrcAddr.Bytes()...)
@@ -282,9 +318,170 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		delAddr.Bytes()...)
 }
 
+// gets the key for the index of the unbonding-delegation or redelegation
+// holding the entry with the unbonding id
+// VALUE: the unbonding-delegation or redelegation key
+func GetUnbondingIndexKey(id uint64) []byte {
+	return append(UnbondingIndexKey, sdk.Uint64ToBigEndian(id)...)
+}
+
 //________________________________________________________________________________
 
 // GetHistoricalInfoKey gets the key for the historical info
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// DecodeStoreKey returns a human readable representation of a raw staking store
+// key. An error is returned if the key prefix is unknown or the key is
+// malformed.
+func DecodeStoreKey(key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("empty key")
+	}
+
+	prefix, rest := key[:1], key[1:]
+
+	// addresses splits the rest of the key into n addresses
+	addresses := func(n int) ([][]byte, error) {
+		if len(rest) != n*sdk.AddrLen {
+			return nil, fmt.Errorf("invalid key length %d; expected %d", len(key), 1+n*sdk.AddrLen)
+		}
+
+		addrs := make([][]byte, n)
+		for i := range addrs {
+			addrs[i] = rest[i*sdk.AddrLen : (i+1)*sdk.AddrLen]
+		}
+
+		return addrs, nil
+	}
+
+	switch {
+	case bytes.Equal(prefix, LastValidatorPowerKey):
+		addrs, err := addresses(1)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("last validator power: validator=%s", sdk.ValAddress(addrs[0])), nil
+
+	case bytes.Equal(prefix, LastTotalPowerKey):
+		return "last total power", nil
+
+	case bytes.Equal(prefix, ValidatorsKey):
+		addrs, err := addresses(1)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("validator: validator=%s", sdk.ValAddress(addrs[0])), nil
+
+	case bytes.Equal(prefix, ValidatorsByConsAddrKey):
+		addrs, err := addresses(1)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("validator by consensus address: consensus=%s", sdk.ConsAddress(addrs[0])), nil
+
+	case bytes.Equal(prefix, ValidatorsByPowerIndexKey):
+		if len(rest) != 8+sdk.AddrLen {
+			return "", fmt.Errorf("invalid key length %d; expected %d", len(key), 1+8+sdk.AddrLen)
+		}
+		power := binary.BigEndian.Uint64(rest[:8])
+		return fmt.Sprintf(
+			"validator by power: power=%d validator=%s", power, sdk.ValAddress(ParseValidatorPowerRankKey(key)),
+		), nil
+
+	case bytes.Equal(prefix, DelegationKey), bytes.Equal(prefix, UnbondingDelegationKey):
+		addrs, err := addresses(2)
+		if err != nil {
+			return "", err
+		}
+		name := "delegation"
+		if bytes.Equal(prefix, UnbondingDelegationKey) {
+			name = "unbonding delegation"
+		}
+		return fmt.Sprintf("%s: delegator=%s validator=%s", name, sdk.AccAddress(addrs[0]), sdk.ValAddress(addrs[1])), nil
+
+	case bytes.Equal(prefix, DelegationByValIndexKey):
+		addrs, err := addresses(2)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"delegation by validator: validator=%s delegator=%s", sdk.ValAddress(addrs[0]), sdk.AccAddress(addrs[1]),
+		), nil
+
+	case bytes.Equal(prefix, UnbondingDelegationByValIndexKey):
+		addrs, err := addresses(2)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"unbonding delegation by validator: validator=%s delegator=%s", sdk.ValAddress(addrs[0]), sdk.AccAddress(addrs[1]),
+		), nil
+
+	case bytes.Equal(prefix, RedelegationKey):
+		addrs, err := addresses(3)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"redelegation: delegator=%s source=%s destination=%s",
+			sdk.AccAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2]),
+		), nil
+
+	case bytes.Equal(prefix, RedelegationByValSrcIndexKey):
+		addrs, err := addresses(3)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"redelegation by source validator: source=%s delegator=%s destination=%s",
+			sdk.ValAddress(addrs[0]), sdk.AccAddress(addrs[1]), sdk.ValAddress(addrs[2]),
+		), nil
+
+	case bytes.Equal(prefix, RedelegationByValDstIndexKey):
+		addrs, err := addresses(3)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"redelegation by destination validator: destination=%s delegator=%s source=%s",
+			sdk.ValAddress(addrs[0]), sdk.AccAddress(addrs[1]), sdk.ValAddress(addrs[2]),
+		), nil
+
+	case bytes.Equal(prefix, UnbondingIDKey):
+		return "unbonding id", nil
+
+	case bytes.Equal(prefix, UnbondingIndexKey):
+		if len(rest) != 8 {
+			return "", fmt.Errorf("invalid key length %d; expected %d", len(key), 1+8)
+		}
+		return fmt.Sprintf("unbonding index: id=%d", binary.BigEndian.Uint64(rest)), nil
+
+	case bytes.Equal(prefix, UnbondingQueueKey), bytes.Equal(prefix, RedelegationQueueKey), bytes.Equal(prefix, ValidatorQueueKey):
+		t, err := sdk.ParseTimeBytes(rest)
+		if err != nil {
+			return "", err
+		}
+		name := "unbonding queue"
+		switch {
+		case bytes.Equal(prefix, RedelegationQueueKey):
+			name = "redelegation queue"
+		case bytes.Equal(prefix, ValidatorQueueKey):
+			name = "validator queue"
+		}
+		return fmt.Sprintf("%s: time=%s", name, t.Format(time.RFC3339Nano)), nil
+
+	case bytes.Equal(prefix, HistoricalInfoKey):
+		height, err := strconv.ParseInt(string(rest), 10, 64)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("historical info: height=%d", height), nil
+
+	default:
+		return "", fmt.Errorf("unknown key prefix %X", prefix)
+	}
+}
@@ -2,10 +2,13 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -88,3 +91,41 @@ func TestGetREDByValSrcIndexKey(t *testing.T) {
 		assert.Equal(t, tt.wantHex, got, "Keys did not match on test case %d", i)
 	}
 }
+
+func TestDecodeStoreKey(t *testing.T) {
+	delAddr := sdk.AccAddress(keysAddr1)
+	valAddr := sdk.ValAddress(keysAddr2)
+	dstAddr := sdk.ValAddress(keysAddr3)
+	now := time.Now().UTC()
+
+	validator := NewValidator(valAddr, keysPK2, Description{})
+	validator.Tokens = sdk.TokensFromConsensusPower(10)
+
+	tests := []struct {
+		key      []byte
+		expected string
+	}{
+		{GetValidatorKey(valAddr), fmt.Sprintf("validator: validator=%s", valAddr)},
+		{GetValidatorsByPowerIndexKey(validator), fmt.Sprintf("validator by power: power=10 validator=%s", valAddr)},
+		{GetDelegationKey(delAddr, valAddr), fmt.Sprintf("delegation: delegator=%s validator=%s", delAddr, valAddr)},
+		{GetDelegationByValIndexKey(delAddr, valAddr), fmt.Sprintf("delegation by validator: validator=%s delegator=%s", valAddr, delAddr)},
+		{GetUBDByValIndexKey(delAddr, valAddr), fmt.Sprintf("unbonding delegation by validator: validator=%s delegator=%s", valAddr, delAddr)},
+		{GetREDKey(delAddr, valAddr, dstAddr), fmt.Sprintf("redelegation: delegator=%s source=%s destination=%s", delAddr, valAddr, dstAddr)},
+		{GetUnbondingIndexKey(7), "unbonding index: id=7"},
+		{GetUnbondingDelegationTimeKey(now), fmt.Sprintf("unbonding queue: time=%s", now.Format(time.RFC3339Nano))},
+		{GetHistoricalInfoKey(5), "historical info: height=5"},
+	}
+
+	for _, tt := range tests {
+		out, err := DecodeStoreKey(tt.key)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, out)
+	}
+
+	_, err := DecodeStoreKey(nil)
+	require.Error(t, err)
+	_, err = DecodeStoreKey([]byte{0xFF})
+	require.Error(t, err)
+	_, err = DecodeStoreKey(GetDelegationsKey(delAddr))
+	require.Error(t, err)
+}
@@ -15,6 +15,7 @@ var (
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgEditDelegation{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -273,3 +274,47 @@ func (msg MsgUndelegate) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgEditDelegation creates a new MsgEditDelegation instance.
+func NewMsgEditDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, newDelAddr sdk.AccAddress) MsgEditDelegation {
+	return MsgEditDelegation{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		NewDelegatorAddress: newDelAddr,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgEditDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgEditDelegation) Type() string { return "edit_delegation" }
+
+// GetSigners implements the sdk.Msg interface. Both the current and the new
+// delegator must sign the message.
+func (msg MsgEditDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress, msg.NewDelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgEditDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEditDelegation) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if msg.NewDelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.DelegatorAddress.Equals(msg.NewDelegatorAddress) {
+		return ErrTransferToSameDelegator
+	}
+	return nil
+}
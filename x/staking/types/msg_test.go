@@ -154,3 +154,30 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgEditDelegation
+func TestMsgEditDelegation(t *testing.T) {
+	tests := []struct {
+		name             string
+		delegatorAddr    sdk.AccAddress
+		validatorAddr    sdk.ValAddress
+		newDelegatorAddr sdk.AccAddress
+		expectPass       bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr3), true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.AccAddress(valAddr3), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.AccAddress(valAddr3), false},
+		{"empty new delegator", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(emptyAddr), false},
+		{"same delegator", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgEditDelegation(tc.delegatorAddr, tc.validatorAddr, tc.newDelegatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			require.Equal(t, []sdk.AccAddress{tc.delegatorAddr, tc.newDelegatorAddr}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -28,6 +28,11 @@ const (
 	// DefaultHistorical entries is 0 since it must only be non-zero for
 	// IBC connected chains
 	DefaultHistoricalEntries uint32 = 0
+
+	// DefaultMaxValidatorsPhaseOutRate removes one validator per block after
+	// MaxValidators has been reduced below the size of the validator set, so
+	// that a large part of the voting power is not replaced in a single block.
+	DefaultMaxValidatorsPhaseOutRate uint32 = 1
 )
 
 // nolint - Keys for parameter access
@@ -37,6 +42,10 @@ var (
 	KeyMaxEntries        = []byte("KeyMaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+
+	KeyMaxRedelegationSharesPerValidator = []byte("MaxRedelegationSharesPerValidator")
+	KeyMinDelegation                     = []byte("MinDelegation")
+	KeyMaxValidatorsPhaseOutRate         = []byte("MaxValidatorsPhaseOutRate")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -44,14 +53,18 @@ var _ params.ParamSet = (*Params)(nil)
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	maxRedelegationSharesPerValidator sdk.Dec, minDelegation sdk.Int, maxValidatorsPhaseOutRate uint32,
 ) Params {
 
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:                     unbondingTime,
+		MaxValidators:                     maxValidators,
+		MaxEntries:                        maxEntries,
+		HistoricalEntries:                 historicalEntries,
+		BondDenom:                         bondDenom,
+		MaxRedelegationSharesPerValidator: maxRedelegationSharesPerValidator,
+		MinDelegation:                     minDelegation,
+		MaxValidatorsPhaseOutRate:         maxValidatorsPhaseOutRate,
 	}
 }
 
@@ -63,6 +76,12 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(
+			KeyMaxRedelegationSharesPerValidator, &p.MaxRedelegationSharesPerValidator,
+			validateMaxRedelegationSharesPerValidator,
+		),
+		params.NewParamSetPair(KeyMinDelegation, &p.MinDelegation, validateMinDelegation),
+		params.NewParamSetPair(KeyMaxValidatorsPhaseOutRate, &p.MaxValidatorsPhaseOutRate, validateMaxValidatorsPhaseOutRate),
 	}
 }
 
@@ -74,6 +93,9 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		sdk.ZeroDec(),
+		sdk.ZeroInt(),
+		DefaultMaxValidatorsPhaseOutRate,
 	)
 }
 
@@ -115,6 +137,15 @@ func (p Params) Validate() error {
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
+	if err := validateMaxRedelegationSharesPerValidator(p.MaxRedelegationSharesPerValidator); err != nil {
+		return err
+	}
+	if err := validateMinDelegation(p.MinDelegation); err != nil {
+		return err
+	}
+	if err := validateMaxValidatorsPhaseOutRate(p.MaxValidatorsPhaseOutRate); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -182,3 +213,40 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxRedelegationSharesPerValidator(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil value, e.g. from a genesis file without the parameter, disables the limit
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max redelegation shares per validator cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinDelegation(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil value, e.g. from a genesis file without the parameter, disables the minimum
+	if v != (sdk.Int{}) && v.IsNegative() {
+		return fmt.Errorf("min delegation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidatorsPhaseOutRate(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
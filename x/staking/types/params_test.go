@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,30 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidateMaxRedelegationShares(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MaxRedelegationSharesPerValidator = sdk.NewDec(100)
+	require.NoError(t, params.Validate())
+
+	params.MaxRedelegationSharesPerValidator = sdk.Dec{}
+	require.NoError(t, params.Validate())
+
+	params.MaxRedelegationSharesPerValidator = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidateMinDelegation(t *testing.T) {
+	params := DefaultParams()
+
+	params.MinDelegation = sdk.NewInt(100)
+	require.NoError(t, params.Validate())
+
+	params.MinDelegation = sdk.Int{}
+	require.NoError(t, params.Validate())
+
+	params.MinDelegation = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+}
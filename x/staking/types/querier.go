@@ -21,6 +21,19 @@ const (
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
 	QueryHistoricalInfo                = "historicalInfo"
+	QueryDelegatorTotalStake           = "delegatorTotalStake"
+	QueryDelegatorMaxDelegatable       = "delegatorMaxDelegatable"
+	QueryRedelegationBudget            = "redelegationBudget"
+	QueryAllDelegations                = "allDelegations"
+	QueryAllUnbondingDelegations       = "allUnbondingDelegations"
+	QueryAllRedelegations              = "allRedelegations"
+)
+
+// Page sizes of the paginated queries, DefaultPaginationLimit applies if no
+// limit is given
+const (
+	DefaultPaginationLimit = 100
+	MaxPaginationLimit     = 1000
 )
 
 // defines the params for the following queries:
@@ -28,6 +41,8 @@ const (
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+// - 'custom/staking/delegatorTotalStake'
+// - 'custom/staking/delegatorMaxDelegatable'
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
 }
@@ -57,6 +72,7 @@ func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams
 // - 'custom/staking/delegation'
 // - 'custom/staking/unbondingDelegation'
 // - 'custom/staking/delegatorValidator'
+// - 'custom/staking/redelegationBudget'
 type QueryBondsParams struct {
 	DelegatorAddr sdk.AccAddress
 	ValidatorAddr sdk.ValAddress
@@ -108,3 +124,41 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// QueryPaginationParams defines the params for the following queries:
+// - 'custom/staking/allDelegations'
+// - 'custom/staking/allUnbondingDelegations'
+// - 'custom/staking/allRedelegations'
+//
+// Key is the NextKey of the previous page and empty for the first page.
+type QueryPaginationParams struct {
+	Key   []byte
+	Limit int
+}
+
+// NewQueryPaginationParams creates a new QueryPaginationParams instance
+func NewQueryPaginationParams(key []byte, limit int) QueryPaginationParams {
+	return QueryPaginationParams{key, limit}
+}
+
+// QueryDelegationsPage is a page of the 'custom/staking/allDelegations' query.
+// NextKey is nil on the last page.
+type QueryDelegationsPage struct {
+	Delegations DelegationResponses `json:"delegations" yaml:"delegations"`
+	NextKey     []byte              `json:"next_key" yaml:"next_key"`
+}
+
+// QueryUnbondingDelegationsPage is a page of the
+// 'custom/staking/allUnbondingDelegations' query. NextKey is nil on the last
+// page.
+type QueryUnbondingDelegationsPage struct {
+	UnbondingDelegations UnbondingDelegationResponses `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	NextKey              []byte                       `json:"next_key" yaml:"next_key"`
+}
+
+// QueryRedelegationsPage is a page of the 'custom/staking/allRedelegations'
+// query. NextKey is nil on the last page.
+type QueryRedelegationsPage struct {
+	Redelegations RedelegationResponses `json:"redelegations" yaml:"redelegations"`
+	NextKey       []byte                `json:"next_key" yaml:"next_key"`
+}
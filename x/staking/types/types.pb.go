@@ -372,6 +372,68 @@ func (m *MsgUndelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgEditDelegation defines an SDK message for transferring the ownership of a
+// delegation from a delegator to a new delegator.
+type MsgEditDelegation struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	NewDelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=new_delegator_address,json=newDelegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_delegator_address,omitempty" yaml:"new_delegator_address"`
+}
+
+func (m *MsgEditDelegation) Reset()         { *m = MsgEditDelegation{} }
+func (m *MsgEditDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgEditDelegation) ProtoMessage()    {}
+func (*MsgEditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c669c0a3ee1b124c, []int{5}
+}
+func (m *MsgEditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditDelegation.Merge(m, src)
+}
+func (m *MsgEditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditDelegation proto.InternalMessageInfo
+
+func (m *MsgEditDelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgEditDelegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgEditDelegation) GetNewDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewDelegatorAddress
+	}
+	return nil
+}
+
 // HistoricalInfo contains the historical information that gets stored at
 // each height.
 type HistoricalInfo struct {
@@ -383,7 +445,7 @@ func (m *HistoricalInfo) Reset()         { *m = HistoricalInfo{} }
 func (m *HistoricalInfo) String() string { return proto.CompactTextString(m) }
 func (*HistoricalInfo) ProtoMessage()    {}
 func (*HistoricalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{5}
+	return fileDescriptor_c669c0a3ee1b124c, []int{6}
 }
 func (m *HistoricalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +499,7 @@ type CommissionRates struct {
 func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
 func (*CommissionRates) ProtoMessage() {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{6}
+	return fileDescriptor_c669c0a3ee1b124c, []int{7}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +537,7 @@ type Commission struct {
 func (m *Commission) Reset()      { *m = Commission{} }
 func (*Commission) ProtoMessage() {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{7}
+	return fileDescriptor_c669c0a3ee1b124c, []int{8}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +585,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{8}
+	return fileDescriptor_c669c0a3ee1b124c, []int{9}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +673,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{9}
+	return fileDescriptor_c669c0a3ee1b124c, []int{10}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +713,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{10}
+	return fileDescriptor_c669c0a3ee1b124c, []int{11}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +765,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{11}
+	return fileDescriptor_c669c0a3ee1b124c, []int{12}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +814,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{12}
+	return fileDescriptor_c669c0a3ee1b124c, []int{13}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +873,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{13}
+	return fileDescriptor_c669c0a3ee1b124c, []int{14}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +921,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{14}
+	return fileDescriptor_c669c0a3ee1b124c, []int{15}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +975,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{15}
+	return fileDescriptor_c669c0a3ee1b124c, []int{16}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,12 +1031,16 @@ type UnbondingDelegationEntry struct {
 	CompletionTime time.Time                              `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
 	Balance        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// unique id of the entry, passed to the unbonding hooks
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty" yaml:"unbonding_id"`
+	// number of holds placed on the completion of the entry
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty" yaml:"unbonding_on_hold_ref_count"`
 }
 
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{16}
+	return fileDescriptor_c669c0a3ee1b124c, []int{17}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,18 +1083,36 @@ func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *UnbondingDelegationEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *UnbondingDelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+	if m != nil {
+		return m.UnbondingOnHoldRefCount
+	}
+	return 0
+}
+
 // RedelegationEntry defines a redelegation object with relevant metadata.
 type RedelegationEntry struct {
 	CreationHeight int64                                  `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
 	CompletionTime time.Time                              `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance" yaml:"initial_balance"`
 	SharesDst      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_dst"`
+	// unique id of the entry, passed to the unbonding hooks
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty" yaml:"unbonding_id"`
+	// number of holds placed on the completion of the entry
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty" yaml:"unbonding_on_hold_ref_count"`
 }
 
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{17}
+	return fileDescriptor_c669c0a3ee1b124c, []int{18}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,6 +1155,20 @@ func (m *RedelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *RedelegationEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *RedelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+	if m != nil {
+		return m.UnbondingOnHoldRefCount
+	}
+	return 0
+}
+
 // Redelegation contains the list of a particular delegator's redelegating bonds
 // from a particular source validator to a particular destination validator.
 type Redelegation struct {
@@ -1083,7 +1181,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{18}
+	return fileDescriptor_c669c0a3ee1b124c, []int{19}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,12 +1245,24 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// max_redelegation_shares_per_validator is the maximum amount of destination
+	// shares a delegator may have in immature redelegations away from a source
+	// validator, to any destination validators. Zero disables the limit.
+	MaxRedelegationSharesPerValidator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_redelegation_shares_per_validator,json=maxRedelegationSharesPerValidator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redelegation_shares_per_validator" yaml:"max_redelegation_shares_per_validator"`
+	// min_delegation is the minimum amount of tokens of a delegation. The rest of
+	// a delegation which falls below it is unbonded automatically. Zero disables
+	// the minimum.
+	MinDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_delegation,json=minDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation" yaml:"min_delegation"`
+	// max_validators_phase_out_rate is the maximum number of validators removed
+	// from the validator set per block while it shrinks after max_validators has
+	// been reduced.
+	MaxValidatorsPhaseOutRate uint32 `protobuf:"varint,8,opt,name=max_validators_phase_out_rate,json=maxValidatorsPhaseOutRate,proto3" json:"max_validators_phase_out_rate,omitempty" yaml:"max_validators_phase_out_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c669c0a3ee1b124c, []int{19}
+	return fileDescriptor_c669c0a3ee1b124c, []int{20}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,12 +1326,20 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxValidatorsPhaseOutRate() uint32 {
+	if m != nil {
+		return m.MaxValidatorsPhaseOutRate
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos_sdk.x.staking.v1.MsgCreateValidator")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos_sdk.x.staking.v1.MsgEditValidator")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos_sdk.x.staking.v1.MsgDelegate")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos_sdk.x.staking.v1.MsgBeginRedelegate")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos_sdk.x.staking.v1.MsgUndelegate")
+	proto.RegisterType((*MsgEditDelegation)(nil), "cosmos_sdk.x.staking.v1.MsgEditDelegation")
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos_sdk.x.staking.v1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos_sdk.x.staking.v1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos_sdk.x.staking.v1.Commission")
@@ -1242,112 +1360,125 @@ func init() {
 func init() { proto.RegisterFile("x/staking/types/types.proto", fileDescriptor_c669c0a3ee1b124c) }
 
 var fileDescriptor_c669c0a3ee1b124c = []byte{
-	// 1678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xdb, 0x8e, 0x9d, 0x3c, 0x4f, 0xec, 0xa4, 0xa3, 0xc9, 0x78, 0xb2, 0xac, 0x3b, 0xf4,
-	0xa2, 0x55, 0x84, 0x58, 0x5b, 0xd9, 0x45, 0x42, 0xca, 0x5e, 0x76, 0x1c, 0x27, 0x4a, 0x50, 0x82,
-	0x66, 0x3b, 0xb3, 0x39, 0xf0, 0x21, 0xab, 0xdc, 0x5d, 0x69, 0x17, 0x71, 0x77, 0x9b, 0xae, 0x72,
-	0xd6, 0x41, 0x5c, 0x91, 0x10, 0x12, 0x62, 0x2f, 0x48, 0x73, 0x1c, 0xf1, 0x0f, 0x70, 0x45, 0x70,
-	0xe1, 0x38, 0xdc, 0x46, 0x20, 0x21, 0xc4, 0xc1, 0xa0, 0x99, 0x0b, 0xe2, 0x04, 0x3e, 0x70, 0xe0,
-	0x84, 0xba, 0xaa, 0xfa, 0x23, 0x6d, 0x7b, 0xc6, 0xc9, 0x30, 0xc3, 0x48, 0x93, 0xcb, 0x8c, 0xeb,
-	0xf5, 0x7b, 0xbf, 0x57, 0xf5, 0xbe, 0xab, 0x02, 0xef, 0x0c, 0xea, 0x94, 0xa1, 0x33, 0xe2, 0xda,
-	0x75, 0x76, 0xd1, 0xc3, 0x54, 0xfc, 0x5b, 0xeb, 0xf9, 0x1e, 0xf3, 0xd4, 0x3b, 0xa6, 0x47, 0x1d,
-	0x8f, 0xb6, 0xa8, 0x75, 0x56, 0x1b, 0xd4, 0x24, 0x5f, 0xed, 0x7c, 0x6b, 0xfd, 0x7d, 0xd6, 0x21,
-	0xbe, 0xd5, 0xea, 0x21, 0x9f, 0x5d, 0xd4, 0x39, 0x6f, 0xdd, 0xf6, 0x6c, 0x2f, 0xfe, 0x25, 0x00,
-	0xd6, 0x3f, 0x1a, 0xe7, 0x63, 0xd8, 0xb5, 0xb0, 0xef, 0x10, 0x97, 0xd5, 0x51, 0xdb, 0x24, 0xe3,
-	0x5a, 0xd7, 0x35, 0xdb, 0xf3, 0xec, 0x2e, 0x16, 0xfc, 0xed, 0xfe, 0x69, 0x9d, 0x11, 0x07, 0x53,
-	0x86, 0x9c, 0x9e, 0x64, 0xa8, 0xa6, 0x19, 0xac, 0xbe, 0x8f, 0x18, 0xf1, 0x5c, 0xf9, 0x7d, 0x65,
-	0x0c, 0x53, 0xff, 0x57, 0x0e, 0xd4, 0x23, 0x6a, 0xef, 0xf8, 0x18, 0x31, 0x7c, 0x82, 0xba, 0xc4,
-	0x42, 0xcc, 0xf3, 0xd5, 0x43, 0x28, 0x5a, 0x98, 0x9a, 0x3e, 0xe9, 0x05, 0xe2, 0x15, 0x65, 0x43,
-	0xd9, 0x2c, 0x7e, 0xf8, 0x95, 0xda, 0x94, 0x63, 0xd7, 0x9a, 0x31, 0x6f, 0x23, 0xf7, 0x78, 0xa8,
-	0xcd, 0x19, 0x49, 0x71, 0xf5, 0x5b, 0x00, 0xa6, 0xe7, 0x38, 0x84, 0xd2, 0x00, 0x2c, 0xc3, 0xc1,
-	0x36, 0xa7, 0x82, 0xed, 0x44, 0xac, 0x06, 0x62, 0x98, 0x4a, 0xc0, 0x04, 0x82, 0xfa, 0x23, 0x58,
-	0x75, 0x88, 0xdb, 0xa2, 0xb8, 0x7b, 0xda, 0xb2, 0x70, 0x17, 0xdb, 0xfc, 0x90, 0x95, 0xec, 0x86,
-	0xb2, 0xb9, 0xd8, 0x38, 0x0c, 0xd8, 0xff, 0x32, 0xd4, 0xde, 0xb7, 0x09, 0xeb, 0xf4, 0xdb, 0x35,
-	0xd3, 0x73, 0xea, 0x42, 0x95, 0xfc, 0xef, 0x03, 0x6a, 0x9d, 0x49, 0x1b, 0x1c, 0xb8, 0x6c, 0x34,
-	0xd4, 0xd6, 0x2f, 0x90, 0xd3, 0xdd, 0xd6, 0x27, 0x40, 0xea, 0xc6, 0x8a, 0x43, 0xdc, 0x63, 0xdc,
-	0x3d, 0x6d, 0x46, 0x34, 0xf5, 0x87, 0xb0, 0x22, 0x39, 0x3c, 0xbf, 0x85, 0x2c, 0xcb, 0xc7, 0x94,
-	0x56, 0x72, 0x1b, 0xca, 0xe6, 0xad, 0xc6, 0xd1, 0x68, 0xa8, 0x55, 0x04, 0xda, 0x18, 0x8b, 0xfe,
-	0x9f, 0xa1, 0xf6, 0xc1, 0x0c, 0x7b, 0xba, 0x67, 0x9a, 0xf7, 0x84, 0x84, 0xb1, 0x1c, 0x81, 0x48,
-	0x4a, 0xa0, 0xfb, 0x3c, 0x74, 0x52, 0xa4, 0x7b, 0x3e, 0xad, 0x7b, 0x8c, 0x65, 0x56, 0xdd, 0x27,
-	0xa8, 0x1b, 0xe9, 0x8e, 0x40, 0x42, 0xdd, 0x6b, 0x90, 0xef, 0xf5, 0xdb, 0x67, 0xf8, 0xa2, 0x92,
-	0x0f, 0x0c, 0x6d, 0xc8, 0x95, 0x5a, 0x87, 0xf9, 0x73, 0xd4, 0xed, 0xe3, 0x4a, 0x81, 0x3b, 0x76,
-	0x35, 0xe9, 0x58, 0xee, 0x4e, 0x12, 0x06, 0x85, 0xe0, 0xd3, 0x7f, 0x9b, 0x85, 0xe5, 0x23, 0x6a,
-	0xef, 0x5a, 0x84, 0xbd, 0xaa, 0x88, 0xeb, 0x4d, 0xb2, 0x53, 0x86, 0xdb, 0x69, 0x67, 0x34, 0xd4,
-	0x4a, 0xc2, 0x4e, 0xff, 0x4b, 0xeb, 0x38, 0x50, 0x8e, 0x23, 0xb4, 0xe5, 0x23, 0x86, 0x65, 0x3c,
-	0x36, 0x67, 0x8c, 0xc5, 0x26, 0x36, 0x47, 0x43, 0x6d, 0x4d, 0xec, 0x2c, 0x05, 0xa5, 0x1b, 0x25,
-	0xf3, 0x52, 0x56, 0xa8, 0x83, 0xc9, 0x29, 0x90, 0xe3, 0x2a, 0xf7, 0x5f, 0x61, 0xf8, 0xeb, 0xbf,
-	0xce, 0x40, 0xf1, 0x88, 0xda, 0x92, 0x82, 0x27, 0xa7, 0x83, 0xf2, 0x7f, 0x4c, 0x87, 0xcc, 0xeb,
-	0x49, 0x87, 0x2d, 0xc8, 0x23, 0xc7, 0xeb, 0xbb, 0x8c, 0xfb, 0xf9, 0xb9, 0x71, 0x2f, 0x19, 0xf5,
-	0x3f, 0x66, 0x79, 0xb1, 0x6d, 0x60, 0x9b, 0xb8, 0x06, 0xb6, 0xde, 0x04, 0x0b, 0xfe, 0x58, 0x81,
-	0xdb, 0xb1, 0x7d, 0xa8, 0x6f, 0xa6, 0xcc, 0xf8, 0xe9, 0x68, 0xa8, 0x7d, 0x29, 0x6d, 0xc6, 0x04,
-	0xdb, 0x35, 0x4c, 0xb9, 0x1a, 0x01, 0x1d, 0xfb, 0xe6, 0xe4, 0x7d, 0x58, 0x94, 0x45, 0xfb, 0xc8,
-	0x4e, 0xdf, 0x47, 0x82, 0xed, 0xa5, 0xf6, 0xd1, 0xa4, 0x6c, 0xdc, 0xab, 0xb9, 0x59, 0xbd, 0xfa,
-	0x9b, 0x0c, 0x2c, 0x1d, 0x51, 0xfb, 0x33, 0xd7, 0xba, 0x49, 0x89, 0x2b, 0xa7, 0xc4, 0x2f, 0x14,
-	0x28, 0xed, 0x13, 0xca, 0x3c, 0x9f, 0x98, 0xa8, 0x7b, 0xe0, 0x9e, 0x7a, 0xea, 0xc7, 0x90, 0xef,
-	0x60, 0x64, 0x61, 0x5f, 0x36, 0x81, 0x77, 0x6b, 0xf1, 0x68, 0x54, 0x0b, 0x46, 0xa3, 0x9a, 0xd8,
-	0xca, 0x3e, 0x67, 0x0a, 0xf1, 0x84, 0x88, 0xfa, 0x09, 0xe4, 0xcf, 0x51, 0x97, 0x62, 0x56, 0xc9,
-	0x6c, 0x64, 0x37, 0x8b, 0x1f, 0xea, 0x53, 0x3b, 0x48, 0xd4, 0x7a, 0x42, 0x04, 0x21, 0xb7, 0x9d,
-	0xfb, 0xfb, 0x23, 0x4d, 0xd1, 0x7f, 0x95, 0x81, 0x72, 0x6a, 0x10, 0x51, 0x1b, 0x90, 0xe3, 0x75,
-	0x5d, 0xe1, 0x45, 0xb6, 0x76, 0x85, 0x39, 0xa3, 0x89, 0x4d, 0x83, 0xcb, 0xaa, 0xdf, 0x85, 0x05,
-	0x07, 0x0d, 0x44, 0x7f, 0xc8, 0x70, 0x9c, 0x7b, 0x57, 0xc3, 0x19, 0x0d, 0xb5, 0xb2, 0x2c, 0xd8,
-	0x12, 0x47, 0x37, 0x0a, 0x0e, 0x1a, 0xf0, 0xae, 0xd0, 0x83, 0x72, 0x40, 0x35, 0x3b, 0xc8, 0xb5,
-	0x71, 0xb2, 0x09, 0xed, 0x5f, 0x59, 0xc9, 0x5a, 0xac, 0x24, 0x01, 0xa7, 0x1b, 0x4b, 0x0e, 0x1a,
-	0xec, 0x70, 0x42, 0xa0, 0x71, 0x7b, 0xe1, 0xe1, 0x23, 0x6d, 0x8e, 0x5b, 0xec, 0x0f, 0x0a, 0x40,
-	0x6c, 0x31, 0xf5, 0x7b, 0xb0, 0x9c, 0x6a, 0x62, 0x54, 0xfa, 0x73, 0xf6, 0xc9, 0x6f, 0x21, 0xd8,
-	0xf5, 0x93, 0xa1, 0xa6, 0x18, 0x65, 0x33, 0xe5, 0x8b, 0xef, 0x40, 0xb1, 0xdf, 0xb3, 0x10, 0xc3,
-	0xad, 0x60, 0x08, 0x96, 0x33, 0xe5, 0x7a, 0x4d, 0x0c, 0xc0, 0xb5, 0x70, 0x00, 0xae, 0x3d, 0x08,
-	0x27, 0xe4, 0x46, 0x35, 0xc0, 0x1a, 0x0d, 0x35, 0x55, 0x9c, 0x2b, 0x21, 0xac, 0x7f, 0xf1, 0x57,
-	0x4d, 0x31, 0x40, 0x50, 0x02, 0x81, 0xc4, 0xa1, 0x7e, 0xaf, 0x40, 0x31, 0x31, 0x6a, 0xa8, 0x15,
-	0x28, 0x38, 0x9e, 0x4b, 0xce, 0x64, 0x70, 0x2e, 0x1a, 0xe1, 0x52, 0x5d, 0x87, 0x05, 0x62, 0x61,
-	0x97, 0x11, 0x76, 0x21, 0x1c, 0x6b, 0x44, 0xeb, 0x40, 0xea, 0x73, 0xdc, 0xa6, 0x24, 0x74, 0x87,
-	0x11, 0x2e, 0xd5, 0x3d, 0x58, 0xa6, 0xd8, 0xec, 0xfb, 0x84, 0x5d, 0xb4, 0x4c, 0xcf, 0x65, 0xc8,
-	0x64, 0xb2, 0x87, 0xbf, 0x33, 0x1a, 0x6a, 0x77, 0xc4, 0x5e, 0xd3, 0x1c, 0xba, 0x51, 0x0e, 0x49,
-	0x3b, 0x82, 0x12, 0x68, 0xb0, 0x30, 0x43, 0xa4, 0x2b, 0xa6, 0xc1, 0x45, 0x23, 0x5c, 0x26, 0xce,
-	0xf2, 0xbb, 0x02, 0x2c, 0xc6, 0xf3, 0xd6, 0xe7, 0xb0, 0xec, 0xf5, 0xb0, 0x3f, 0xa1, 0x44, 0x1d,
-	0xc6, 0x9a, 0xd3, 0x1c, 0xd7, 0xa8, 0x12, 0xe5, 0x10, 0x23, 0x2c, 0x12, 0x7b, 0x41, 0x60, 0xb8,
-	0x14, 0xbb, 0xb4, 0x4f, 0x5b, 0x72, 0xa0, 0xcc, 0xa4, 0x8f, 0x9c, 0xe6, 0xd0, 0x83, 0x08, 0x90,
-	0xa4, 0xfb, 0x62, 0xec, 0x5c, 0x83, 0xfc, 0xf7, 0x11, 0xe9, 0x62, 0x8b, 0xdb, 0x74, 0xc1, 0x90,
-	0x2b, 0xf5, 0x00, 0xf2, 0x94, 0x21, 0xd6, 0x17, 0x33, 0xf9, 0x7c, 0x63, 0x6b, 0xc6, 0x3d, 0x37,
-	0x3c, 0xd7, 0x3a, 0xe6, 0x82, 0x86, 0x04, 0x50, 0xf7, 0x20, 0xcf, 0xbc, 0x33, 0xec, 0x4a, 0xa3,
-	0x5e, 0x29, 0xe5, 0x0f, 0x5c, 0x66, 0x48, 0x69, 0x95, 0x41, 0x5c, 0xa7, 0x5b, 0xb4, 0x83, 0x7c,
-	0x4c, 0xc5, 0x0c, 0xdd, 0x38, 0xb8, 0x72, 0x5e, 0xde, 0x49, 0x37, 0x0f, 0x81, 0xa7, 0x1b, 0xe5,
-	0x88, 0x74, 0xcc, 0x29, 0xe9, 0x89, 0xba, 0xf0, 0x72, 0x13, 0xf5, 0x1e, 0x2c, 0xf7, 0xdd, 0xb6,
-	0xe7, 0x5a, 0xc4, 0xb5, 0x5b, 0x1d, 0x4c, 0xec, 0x0e, 0xab, 0x2c, 0x6c, 0x28, 0x9b, 0xd9, 0xa4,
-	0xdb, 0xd2, 0x1c, 0xba, 0x51, 0x8e, 0x48, 0xfb, 0x9c, 0xa2, 0x5a, 0x50, 0x8a, 0xb9, 0x78, 0xee,
-	0x2e, 0xbe, 0x30, 0x77, 0xbf, 0x2c, 0x73, 0xf7, 0x76, 0x5a, 0x4b, 0x9c, 0xbe, 0x4b, 0x11, 0x31,
-	0x10, 0x53, 0x0f, 0x2e, 0xdd, 0x38, 0x81, 0x6b, 0x78, 0x6f, 0x86, 0xba, 0x33, 0xfb, 0x65, 0xb3,
-	0xf8, 0x5a, 0x2e, 0x9b, 0xdb, 0xb7, 0x7e, 0xf2, 0x48, 0x9b, 0x8b, 0x52, 0xf8, 0xa7, 0x19, 0xc8,
-	0x37, 0x4f, 0xee, 0x23, 0xe2, 0xbf, 0xad, 0x33, 0x46, 0xa2, 0x9e, 0xed, 0x41, 0x41, 0xd8, 0x82,
-	0xaa, 0x1f, 0xc3, 0x7c, 0x2f, 0xf8, 0x51, 0x51, 0x78, 0xd3, 0xd7, 0xa6, 0x07, 0x39, 0x17, 0x08,
-	0xaf, 0xa3, 0x5c, 0x46, 0xff, 0x65, 0x16, 0xa0, 0x79, 0x72, 0xf2, 0xc0, 0x27, 0xbd, 0x2e, 0x66,
-	0x37, 0xd3, 0xf8, 0x9b, 0x33, 0x8d, 0x27, 0x9c, 0xfd, 0x00, 0x8a, 0xb1, 0x8f, 0xa8, 0xba, 0x0b,
-	0x0b, 0x4c, 0xfe, 0x96, 0x3e, 0x7f, 0xef, 0x39, 0x3e, 0x0f, 0xe5, 0xa4, 0xdf, 0x23, 0x51, 0xfd,
-	0x4f, 0x19, 0x80, 0x17, 0xbd, 0xec, 0xbc, 0x05, 0x73, 0xfb, 0x1e, 0xe4, 0x65, 0x57, 0xca, 0x5e,
-	0x6b, 0xb4, 0x95, 0xd2, 0x09, 0x77, 0xfd, 0x23, 0x03, 0xab, 0x9f, 0x85, 0x15, 0xf9, 0xc6, 0xc2,
-	0xea, 0xa7, 0x50, 0xc0, 0x2e, 0xf3, 0x09, 0x37, 0x71, 0x10, 0xae, 0x5b, 0x53, 0xc3, 0x75, 0x82,
-	0xd9, 0x76, 0x5d, 0xe6, 0x5f, 0xc8, 0xe0, 0x0d, 0x71, 0x12, 0xc6, 0xfe, 0x79, 0x16, 0x2a, 0xd3,
-	0xa4, 0xd4, 0x1d, 0x28, 0x9b, 0x3e, 0xe6, 0x84, 0xb0, 0x6d, 0x2b, 0xbc, 0x6d, 0xaf, 0x27, 0x5e,
-	0x9b, 0x2e, 0x33, 0xe8, 0x46, 0x29, 0xa4, 0xc8, 0xa6, 0x6d, 0xf3, 0xc7, 0xad, 0x20, 0x67, 0x02,
-	0xae, 0x19, 0x27, 0x6e, 0x5d, 0x76, 0xed, 0xf8, 0x49, 0x2b, 0x09, 0x20, 0xda, 0x76, 0x29, 0xa6,
-	0xf2, 0xbe, 0xfd, 0x03, 0x28, 0x13, 0x97, 0x30, 0x82, 0xba, 0xad, 0x36, 0xea, 0x22, 0xd7, 0xbc,
-	0xce, 0x05, 0x46, 0x34, 0x5a, 0xa9, 0x36, 0x05, 0xa7, 0x1b, 0x25, 0x49, 0x69, 0x08, 0x82, 0xba,
-	0x0f, 0x85, 0x50, 0x55, 0xee, 0x5a, 0x53, 0x5e, 0x28, 0x9e, 0xf0, 0xc8, 0xcf, 0xb2, 0xb0, 0x12,
-	0x3d, 0xf0, 0xdc, 0xb8, 0x62, 0x56, 0x57, 0x1c, 0x01, 0x88, 0x4a, 0x12, 0xf4, 0x92, 0x6b, 0x78,
-	0x23, 0xa8, 0x45, 0x8b, 0x02, 0xa1, 0x49, 0x59, 0xc2, 0x1f, 0xff, 0xcc, 0xc2, 0xad, 0xa4, 0x3f,
-	0x6e, 0x9a, 0xfc, 0x1b, 0xf4, 0xe4, 0xf6, 0xcd, 0xb8, 0x36, 0xe6, 0x78, 0x6d, 0xfc, 0xea, 0xd4,
-	0xda, 0x38, 0x96, 0x53, 0xd3, 0x8b, 0xe2, 0xbf, 0x33, 0x90, 0xbf, 0x8f, 0x7c, 0xe4, 0x50, 0xd5,
-	0x1c, 0xbb, 0x72, 0x88, 0x87, 0x88, 0xbb, 0x63, 0x19, 0xd3, 0x94, 0x7f, 0x2f, 0x7b, 0xc1, 0x8d,
-	0xe3, 0xe1, 0x84, 0x1b, 0xc7, 0x27, 0x50, 0x72, 0xd0, 0xa0, 0x15, 0x1d, 0x50, 0x78, 0x73, 0xa9,
-	0x71, 0x37, 0x46, 0xb9, 0xfc, 0x5d, 0x3c, 0xa5, 0x44, 0x17, 0x72, 0xaa, 0x7e, 0x03, 0x8a, 0x01,
-	0x47, 0xdc, 0x27, 0x02, 0xf1, 0xb5, 0xf8, 0xc9, 0x22, 0xf1, 0x51, 0x37, 0xc0, 0x41, 0x83, 0x5d,
-	0xb1, 0x50, 0x0f, 0x41, 0xed, 0x44, 0x4f, 0x68, 0xad, 0xd8, 0x96, 0x81, 0xfc, 0xbb, 0xa3, 0xa1,
-	0x76, 0x57, 0xc8, 0x8f, 0xf3, 0xe8, 0xc6, 0x4a, 0x4c, 0x0c, 0xd1, 0xbe, 0x0e, 0x10, 0x9c, 0xab,
-	0x65, 0x61, 0xd7, 0x73, 0xe4, 0xc5, 0xf7, 0xf6, 0x68, 0xa8, 0xad, 0x08, 0x94, 0xf8, 0x9b, 0x6e,
-	0x2c, 0x06, 0x8b, 0x66, 0xf0, 0x3b, 0x36, 0x7c, 0x63, 0xef, 0xf1, 0xd3, 0xaa, 0xf2, 0xe4, 0x69,
-	0x55, 0xf9, 0xdb, 0xd3, 0xaa, 0xf2, 0xc5, 0xb3, 0xea, 0xdc, 0x93, 0x67, 0xd5, 0xb9, 0x3f, 0x3f,
-	0xab, 0xce, 0x7d, 0xfb, 0x6b, 0xcf, 0x0d, 0x96, 0xd4, 0xdf, 0x5b, 0xdb, 0x79, 0xee, 0x95, 0x8f,
-	0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x62, 0xe9, 0xa4, 0xfa, 0x89, 0x1d, 0x00, 0x00,
+	// 1886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4b, 0x6f, 0x1b, 0xc7,
+	0x59, 0x4b, 0xd2, 0x94, 0xf4, 0xd1, 0x12, 0xa5, 0x15, 0x6c, 0xd1, 0x72, 0xad, 0x55, 0x36, 0xa9,
+	0x21, 0x14, 0x09, 0x05, 0x27, 0x05, 0x0a, 0x28, 0x97, 0x98, 0xa2, 0x55, 0xa9, 0xb0, 0x1a, 0x65,
+	0xed, 0xe8, 0xd0, 0xd7, 0x62, 0xb4, 0x3b, 0x22, 0x27, 0xe2, 0xee, 0xb2, 0x3b, 0x43, 0x5b, 0x2a,
+	0x7a, 0x0d, 0x50, 0xf4, 0x14, 0xa0, 0x28, 0x90, 0xa3, 0xd1, 0x3f, 0x90, 0xf6, 0x56, 0xb4, 0x97,
+	0x1e, 0xd3, 0x9b, 0xd1, 0x02, 0x45, 0xd1, 0xc3, 0xb6, 0xb0, 0x2f, 0x45, 0x4f, 0x2d, 0x8f, 0x3d,
+	0x15, 0xf3, 0xd8, 0x87, 0x96, 0x64, 0x44, 0x2a, 0x4d, 0xea, 0xc2, 0xba, 0x48, 0x3b, 0xdf, 0x7c,
+	0x8f, 0x99, 0xef, 0x31, 0xdf, 0x43, 0x82, 0x9b, 0x27, 0x1b, 0x94, 0xa1, 0x63, 0xe2, 0xb7, 0x36,
+	0xd8, 0x69, 0x17, 0x53, 0xf9, 0xb3, 0xde, 0x0d, 0x03, 0x16, 0xe8, 0xcb, 0x4e, 0x40, 0xbd, 0x80,
+	0xda, 0xd4, 0x3d, 0xae, 0x9f, 0xd4, 0x15, 0x5e, 0xfd, 0xd1, 0x9d, 0x95, 0xdb, 0xac, 0x4d, 0x42,
+	0xd7, 0xee, 0xa2, 0x90, 0x9d, 0x6e, 0x08, 0xdc, 0x8d, 0x56, 0xd0, 0x0a, 0xd2, 0x2f, 0xc9, 0x60,
+	0xe5, 0xad, 0x41, 0x3c, 0x86, 0x7d, 0x17, 0x87, 0x1e, 0xf1, 0xd9, 0x06, 0x3a, 0x74, 0xc8, 0xa0,
+	0xd4, 0x15, 0xa3, 0x15, 0x04, 0xad, 0x0e, 0x96, 0xf8, 0x87, 0xbd, 0xa3, 0x0d, 0x46, 0x3c, 0x4c,
+	0x19, 0xf2, 0xba, 0x0a, 0x61, 0x35, 0x8f, 0xe0, 0xf6, 0x42, 0xc4, 0x48, 0xe0, 0xab, 0xfd, 0xc5,
+	0x01, 0x9e, 0xe6, 0xbf, 0x4a, 0xa0, 0xef, 0xd1, 0xd6, 0x56, 0x88, 0x11, 0xc3, 0x07, 0xa8, 0x43,
+	0x5c, 0xc4, 0x82, 0x50, 0xbf, 0x0f, 0x15, 0x17, 0x53, 0x27, 0x24, 0x5d, 0x4e, 0x5e, 0xd3, 0xd6,
+	0xb4, 0xf5, 0xca, 0x9b, 0xaf, 0xd5, 0x47, 0x5c, 0xbb, 0xde, 0x4c, 0x71, 0x1b, 0xa5, 0x4f, 0x23,
+	0x63, 0xca, 0xca, 0x92, 0xeb, 0xdf, 0x06, 0x70, 0x02, 0xcf, 0x23, 0x94, 0x72, 0x66, 0x05, 0xc1,
+	0x6c, 0x7d, 0x24, 0xb3, 0xad, 0x04, 0xd5, 0x42, 0x0c, 0x53, 0xc5, 0x30, 0xc3, 0x41, 0xff, 0x31,
+	0x2c, 0x79, 0xc4, 0xb7, 0x29, 0xee, 0x1c, 0xd9, 0x2e, 0xee, 0xe0, 0x96, 0xb8, 0x64, 0xad, 0xb8,
+	0xa6, 0xad, 0xcf, 0x36, 0xee, 0x73, 0xf4, 0xbf, 0x44, 0xc6, 0xed, 0x16, 0x61, 0xed, 0xde, 0x61,
+	0xdd, 0x09, 0xbc, 0x0d, 0x29, 0x4a, 0xfd, 0x7a, 0x83, 0xba, 0xc7, 0x4a, 0x07, 0xbb, 0x3e, 0xeb,
+	0x47, 0xc6, 0xca, 0x29, 0xf2, 0x3a, 0x9b, 0xe6, 0x10, 0x96, 0xa6, 0xb5, 0xe8, 0x11, 0xff, 0x01,
+	0xee, 0x1c, 0x35, 0x13, 0x98, 0xfe, 0x23, 0x58, 0x54, 0x18, 0x41, 0x68, 0x23, 0xd7, 0x0d, 0x31,
+	0xa5, 0xb5, 0xd2, 0x9a, 0xb6, 0x7e, 0xb5, 0xb1, 0xd7, 0x8f, 0x8c, 0x9a, 0xe4, 0x36, 0x80, 0x62,
+	0xfe, 0x3b, 0x32, 0xde, 0x18, 0xe3, 0x4c, 0x77, 0x1d, 0xe7, 0xae, 0xa4, 0xb0, 0x16, 0x12, 0x26,
+	0x0a, 0xc2, 0x65, 0x3f, 0x8a, 0x8d, 0x94, 0xc8, 0xbe, 0x92, 0x97, 0x3d, 0x80, 0x32, 0xae, 0xec,
+	0x03, 0xd4, 0x49, 0x64, 0x27, 0x4c, 0x62, 0xd9, 0xd7, 0xa1, 0xdc, 0xed, 0x1d, 0x1e, 0xe3, 0xd3,
+	0x5a, 0x99, 0x2b, 0xda, 0x52, 0x2b, 0x7d, 0x03, 0xae, 0x3c, 0x42, 0x9d, 0x1e, 0xae, 0x4d, 0x0b,
+	0xc3, 0x2e, 0x65, 0x0d, 0x2b, 0xcc, 0x49, 0x62, 0xa7, 0x90, 0x78, 0xe6, 0x6f, 0x8b, 0xb0, 0xb0,
+	0x47, 0x5b, 0xf7, 0x5c, 0xc2, 0xbe, 0x28, 0x8f, 0xeb, 0x0e, 0xd3, 0x53, 0x41, 0xe8, 0x69, 0xab,
+	0x1f, 0x19, 0xf3, 0x52, 0x4f, 0xff, 0x4d, 0xed, 0x78, 0x50, 0x4d, 0x3d, 0xd4, 0x0e, 0x11, 0xc3,
+	0xca, 0x1f, 0x9b, 0x63, 0xfa, 0x62, 0x13, 0x3b, 0xfd, 0xc8, 0xb8, 0x2e, 0x4f, 0x96, 0x63, 0x65,
+	0x5a, 0xf3, 0xce, 0x99, 0xa8, 0xd0, 0x4f, 0x86, 0x87, 0x40, 0x49, 0x88, 0xdc, 0xf9, 0x02, 0xdd,
+	0xdf, 0xfc, 0x75, 0x01, 0x2a, 0x7b, 0xb4, 0xa5, 0x20, 0x78, 0x78, 0x38, 0x68, 0xff, 0xc3, 0x70,
+	0x28, 0x7c, 0x39, 0xe1, 0x70, 0x07, 0xca, 0xc8, 0x0b, 0x7a, 0x3e, 0xab, 0x15, 0xcf, 0xf3, 0x7b,
+	0x85, 0x68, 0xfe, 0xb1, 0x28, 0x1e, 0xdb, 0x06, 0x6e, 0x11, 0xdf, 0xc2, 0xee, 0x8b, 0xa0, 0xc1,
+	0x0f, 0x35, 0xb8, 0x96, 0xea, 0x87, 0x86, 0x4e, 0x4e, 0x8d, 0xef, 0xf5, 0x23, 0xe3, 0x2b, 0x79,
+	0x35, 0x66, 0xd0, 0x2e, 0xa0, 0xca, 0xa5, 0x84, 0xd1, 0x83, 0xd0, 0x19, 0x7e, 0x0e, 0x97, 0xb2,
+	0xe4, 0x1c, 0xc5, 0xd1, 0xe7, 0xc8, 0xa0, 0x7d, 0xae, 0x73, 0x34, 0x29, 0x1b, 0xb4, 0x6a, 0x69,
+	0x5c, 0xab, 0xfe, 0xa6, 0x00, 0x73, 0x7b, 0xb4, 0xf5, 0xbe, 0xef, 0x5e, 0x86, 0xc4, 0xc4, 0x21,
+	0xf1, 0xb3, 0x22, 0x2c, 0xaa, 0x5c, 0x70, 0x5e, 0x8a, 0x7d, 0x09, 0x14, 0xc8, 0xa3, 0xc0, 0xc7,
+	0x8f, 0xed, 0xc1, 0xcb, 0x0f, 0x44, 0xc1, 0x50, 0xb4, 0x0b, 0x28, 0x60, 0xc9, 0xc7, 0x8f, 0x9b,
+	0x39, 0x1d, 0x98, 0x3f, 0xd7, 0x60, 0x7e, 0x87, 0x50, 0x16, 0x84, 0xc4, 0x41, 0x9d, 0x5d, 0xff,
+	0x28, 0xd0, 0xdf, 0x86, 0x72, 0x1b, 0x23, 0x17, 0x87, 0x2a, 0x35, 0xdf, 0xaa, 0xa7, 0x05, 0x6b,
+	0x9d, 0x17, 0xac, 0x75, 0xc9, 0x7a, 0x47, 0x20, 0xc5, 0x56, 0x96, 0x24, 0xfa, 0x3b, 0x50, 0x7e,
+	0x84, 0x3a, 0x14, 0xb3, 0x5a, 0x61, 0xad, 0xb8, 0x5e, 0x79, 0xd3, 0x1c, 0x99, 0xd7, 0x93, 0x82,
+	0x20, 0xe6, 0x20, 0xe9, 0x36, 0x4b, 0x7f, 0x7f, 0x62, 0x68, 0xe6, 0x27, 0x05, 0xa8, 0xe6, 0xca,
+	0x43, 0xbd, 0x01, 0x25, 0x91, 0x6d, 0x35, 0x91, 0xfa, 0xea, 0x13, 0x54, 0x7f, 0x4d, 0xec, 0x58,
+	0x82, 0x56, 0xff, 0x1e, 0xcc, 0x78, 0xe8, 0x44, 0x66, 0xed, 0x82, 0xe0, 0x73, 0x77, 0x32, 0x3e,
+	0xfd, 0xc8, 0xa8, 0xaa, 0x34, 0xaa, 0xf8, 0x98, 0xd6, 0xb4, 0x87, 0x4e, 0x44, 0xae, 0xee, 0x42,
+	0x95, 0x43, 0x9d, 0x36, 0xf2, 0x5b, 0x38, 0x5b, 0x1a, 0xec, 0x4c, 0x2c, 0xe4, 0x7a, 0x2a, 0x24,
+	0xc3, 0xce, 0xb4, 0xe6, 0x3c, 0x74, 0xb2, 0x25, 0x00, 0x5c, 0xe2, 0xe6, 0xcc, 0xc7, 0x4f, 0x8c,
+	0x29, 0xa1, 0xb1, 0x3f, 0x68, 0x00, 0xa9, 0xc6, 0xf4, 0xef, 0xc3, 0x42, 0xae, 0xb4, 0xa0, 0x35,
+	0x6d, 0xc2, 0x7a, 0x7c, 0x86, 0x9f, 0xfa, 0x69, 0x64, 0x68, 0x56, 0xd5, 0xc9, 0xd9, 0xe2, 0xbb,
+	0x50, 0xe9, 0x75, 0x5d, 0xc4, 0xb0, 0xcd, 0x5b, 0x13, 0x55, 0xe9, 0xaf, 0xd4, 0x65, 0x5b, 0x52,
+	0x8f, 0xdb, 0x92, 0xfa, 0xc3, 0xb8, 0x6f, 0x69, 0xac, 0x72, 0x5e, 0xfd, 0xc8, 0xd0, 0xe5, 0xbd,
+	0x32, 0xc4, 0xe6, 0x47, 0x7f, 0x35, 0x34, 0x0b, 0x24, 0x84, 0x13, 0x64, 0x2e, 0xf5, 0x7b, 0x0d,
+	0x2a, 0x99, 0x02, 0x50, 0xaf, 0xc1, 0xb4, 0x17, 0xf8, 0xe4, 0x58, 0x39, 0xe7, 0xac, 0x15, 0x2f,
+	0xf5, 0x15, 0x98, 0x21, 0x2e, 0xf6, 0x19, 0x61, 0xa7, 0xd2, 0xb0, 0x56, 0xb2, 0xe6, 0x54, 0x8f,
+	0xf1, 0x21, 0x25, 0xb1, 0x39, 0xac, 0x78, 0xa9, 0x6f, 0xc3, 0x02, 0xc5, 0x4e, 0x2f, 0x24, 0xec,
+	0xd4, 0x76, 0x02, 0x9f, 0x21, 0x87, 0xa9, 0xca, 0xea, 0x66, 0x3f, 0x32, 0x96, 0xe5, 0x59, 0xf3,
+	0x18, 0xa6, 0x55, 0x8d, 0x41, 0x5b, 0x12, 0xc2, 0x25, 0xb8, 0x98, 0x21, 0xd2, 0x91, 0x35, 0xfa,
+	0xac, 0x15, 0x2f, 0x33, 0x77, 0xf9, 0xdd, 0x34, 0xcc, 0xa6, 0x55, 0xf0, 0x63, 0x58, 0x08, 0xba,
+	0x38, 0x1c, 0xf2, 0xee, 0xdd, 0x4f, 0x25, 0xe7, 0x31, 0x2e, 0xf0, 0xf4, 0x54, 0x63, 0x1e, 0xf1,
+	0xcb, 0xb3, 0xcd, 0x1d, 0xc3, 0xa7, 0xd8, 0xa7, 0x3d, 0x6a, 0xab, 0x32, 0xbf, 0x90, 0xbf, 0x72,
+	0x1e, 0xc3, 0xb4, 0xaa, 0x09, 0x68, 0x5f, 0x40, 0x78, 0x93, 0xf0, 0x01, 0x22, 0x1d, 0xec, 0x0a,
+	0x9d, 0xce, 0x58, 0x6a, 0xa5, 0xef, 0x42, 0x99, 0x32, 0xc4, 0x7a, 0xb2, 0x53, 0xba, 0xd2, 0xb8,
+	0x33, 0xe6, 0x99, 0x1b, 0x81, 0xef, 0x3e, 0x10, 0x84, 0x96, 0x62, 0xa0, 0x6f, 0x43, 0x99, 0x05,
+	0xc7, 0xd8, 0x57, 0x4a, 0x9d, 0x28, 0xe4, 0x77, 0x7d, 0x66, 0x29, 0x6a, 0x9d, 0x41, 0xfa, 0xf8,
+	0xdb, 0xb4, 0x8d, 0x42, 0x4c, 0x65, 0x67, 0xd3, 0xd8, 0x9d, 0x38, 0x2e, 0x97, 0xf3, 0x19, 0x49,
+	0xf2, 0x33, 0xad, 0x6a, 0x02, 0x7a, 0x20, 0x20, 0xf9, 0x3e, 0x67, 0xfa, 0xf3, 0xf5, 0x39, 0xdb,
+	0xb0, 0xd0, 0xf3, 0x0f, 0x03, 0xdf, 0x25, 0x7e, 0xcb, 0x6e, 0x63, 0xd2, 0x6a, 0xb3, 0xda, 0xcc,
+	0x9a, 0xb6, 0x5e, 0xcc, 0x9a, 0x2d, 0x8f, 0x61, 0x5a, 0xd5, 0x04, 0xb4, 0x23, 0x20, 0xba, 0x0b,
+	0xf3, 0x29, 0x96, 0x88, 0xdd, 0xd9, 0x73, 0x63, 0xf7, 0x15, 0x15, 0xbb, 0xd7, 0xf2, 0x52, 0xd2,
+	0xf0, 0x9d, 0x4b, 0x80, 0x9c, 0x4c, 0xdf, 0x3d, 0x33, 0x07, 0x00, 0x21, 0xe1, 0xd5, 0x31, 0xde,
+	0x9d, 0xf1, 0x47, 0x00, 0x95, 0x2f, 0x65, 0x04, 0xb0, 0x79, 0xf5, 0x27, 0x4f, 0x8c, 0xa9, 0x24,
+	0x84, 0x7f, 0x5a, 0x80, 0x72, 0xf3, 0x60, 0x1f, 0x91, 0xf0, 0x65, 0x2d, 0x5c, 0x32, 0xef, 0xd9,
+	0x36, 0x4c, 0x4b, 0x5d, 0x50, 0xfd, 0x6d, 0xb8, 0xd2, 0xe5, 0x1f, 0x35, 0x4d, 0x24, 0x7d, 0x63,
+	0xb4, 0x93, 0x0b, 0x82, 0x78, 0x48, 0x20, 0x68, 0xcc, 0x5f, 0x14, 0x01, 0x9a, 0x07, 0x07, 0x0f,
+	0x43, 0xd2, 0xed, 0x60, 0x76, 0xd9, 0x23, 0xbd, 0x38, 0x3d, 0x52, 0xc6, 0xd8, 0x0f, 0xa1, 0x92,
+	0xda, 0x88, 0xea, 0xf7, 0x60, 0x86, 0xa9, 0x6f, 0x65, 0xf3, 0x57, 0x3f, 0xc3, 0xe6, 0x31, 0x9d,
+	0xb2, 0x7b, 0x42, 0x6a, 0xfe, 0xa9, 0x00, 0x70, 0xd9, 0x0c, 0xf0, 0x3c, 0xa7, 0xb2, 0x52, 0xf1,
+	0x42, 0xa5, 0xad, 0xa2, 0xce, 0x98, 0xeb, 0x1f, 0x05, 0x58, 0x7a, 0x3f, 0x7e, 0x91, 0x2f, 0x35,
+	0xac, 0xbf, 0x07, 0xd3, 0xd8, 0x67, 0x21, 0x11, 0x2a, 0xe6, 0xee, 0x7a, 0x67, 0xa4, 0xbb, 0x0e,
+	0x51, 0xdb, 0x3d, 0x9f, 0x85, 0xa7, 0xca, 0x79, 0x63, 0x3e, 0x19, 0x65, 0xff, 0xaa, 0x04, 0xb5,
+	0x51, 0x54, 0xfa, 0x16, 0x54, 0x9d, 0x10, 0x0b, 0x40, 0x9c, 0xb6, 0x35, 0x91, 0xb6, 0x57, 0x32,
+	0x33, 0xc0, 0xb3, 0x08, 0x7c, 0x06, 0xa8, 0x20, 0x2a, 0x69, 0xb7, 0xc4, 0xc8, 0x91, 0xc7, 0x0c,
+	0xc7, 0x1a, 0xb3, 0xe2, 0x36, 0x55, 0xd6, 0x4e, 0x07, 0x8d, 0x59, 0x06, 0x32, 0x6d, 0xcf, 0xa7,
+	0x50, 0x91, 0xb7, 0x7f, 0x08, 0x55, 0xe2, 0x13, 0x46, 0x50, 0xc7, 0x3e, 0x44, 0x1d, 0xe4, 0x3b,
+	0x17, 0x69, 0x60, 0x64, 0xa2, 0x55, 0x62, 0x73, 0xec, 0x4c, 0x6b, 0x5e, 0x41, 0x1a, 0x12, 0xa0,
+	0xef, 0xc0, 0x74, 0x2c, 0xaa, 0x74, 0xa1, 0x2a, 0x2f, 0x26, 0xd7, 0x37, 0xe1, 0x6a, 0x5a, 0x9a,
+	0x10, 0x57, 0x14, 0x8d, 0xa5, 0xc6, 0x72, 0x3f, 0x32, 0x96, 0xf2, 0x85, 0x0b, 0x71, 0x4d, 0xab,
+	0x92, 0x2c, 0x77, 0x5d, 0xdd, 0x85, 0x9b, 0xe9, 0x2e, 0xb7, 0x44, 0xd0, 0x71, 0xed, 0x10, 0x1f,
+	0xd9, 0x8e, 0x98, 0x72, 0x94, 0x85, 0xc9, 0x6e, 0xf7, 0x23, 0xc3, 0xcc, 0xb3, 0x1a, 0x40, 0x36,
+	0xad, 0xe5, 0x64, 0xf7, 0x5d, 0x7f, 0x27, 0xe8, 0xb8, 0x16, 0x3e, 0xda, 0xe2, 0x3b, 0x19, 0x9f,
+	0xf9, 0xa4, 0x04, 0x8b, 0xc9, 0x60, 0xf0, 0xd2, 0x59, 0xc6, 0x75, 0x96, 0x3d, 0x00, 0xf9, 0xd6,
+	0xf1, 0x6c, 0x57, 0x2b, 0x5d, 0xe8, 0xb5, 0x9c, 0x95, 0x1c, 0x9a, 0x94, 0xfd, 0x5f, 0x79, 0xcc,
+	0x3f, 0x8b, 0x70, 0x35, 0xeb, 0x31, 0x97, 0x85, 0xd2, 0x0b, 0x34, 0x4c, 0xfe, 0x56, 0x9a, 0x5f,
+	0x4a, 0x22, 0xbf, 0x7c, 0x6d, 0x64, 0x7e, 0x19, 0x88, 0xfa, 0xd1, 0x89, 0xe5, 0xc3, 0x32, 0x94,
+	0xf7, 0x51, 0x88, 0x3c, 0xaa, 0x3b, 0x03, 0x6d, 0x9b, 0x1c, 0xe6, 0xdc, 0x18, 0x88, 0xe9, 0xa6,
+	0xfa, 0x4b, 0xf0, 0x39, 0x5d, 0xdb, 0xc7, 0x43, 0xba, 0xb6, 0x77, 0x60, 0x9e, 0xcf, 0x9b, 0x92,
+	0x0b, 0x4a, 0x6b, 0xce, 0x35, 0x6e, 0xa4, 0x5c, 0xce, 0xee, 0xcb, 0x71, 0x54, 0x32, 0xd4, 0xa0,
+	0xfa, 0x37, 0xa0, 0xc2, 0x31, 0xd2, 0x5c, 0xcb, 0xc9, 0xaf, 0xa7, 0x63, 0x9f, 0xcc, 0xa6, 0x69,
+	0x81, 0x87, 0x4e, 0xee, 0xc9, 0x85, 0x7e, 0x1f, 0xf4, 0x76, 0x32, 0x86, 0xb4, 0x53, 0x5d, 0x72,
+	0xfa, 0x5b, 0xfd, 0xc8, 0xb8, 0x21, 0xe9, 0x07, 0x71, 0x4c, 0x6b, 0x31, 0x05, 0xc6, 0xdc, 0xbe,
+	0x0e, 0xc0, 0xef, 0x65, 0xbb, 0xd8, 0x0f, 0x3c, 0x35, 0x3c, 0xb8, 0xd6, 0x8f, 0x8c, 0x45, 0xc9,
+	0x25, 0xdd, 0x33, 0xad, 0x59, 0xbe, 0x68, 0xf2, 0x6f, 0xfd, 0x97, 0x1a, 0x7c, 0x95, 0x1f, 0x30,
+	0xcc, 0x58, 0x48, 0xb5, 0xf7, 0x76, 0x17, 0x87, 0xe9, 0xbd, 0xd5, 0xf0, 0xe0, 0x07, 0x13, 0x0f,
+	0x0f, 0x5e, 0x4f, 0xb5, 0x70, 0xae, 0x10, 0xd3, 0x7a, 0x85, 0x8f, 0x15, 0x33, 0x68, 0x72, 0xae,
+	0xb0, 0x8f, 0xc3, 0x74, 0x8a, 0xe4, 0xc3, 0x3c, 0xef, 0x64, 0x53, 0x1c, 0x31, 0x66, 0x98, 0x6d,
+	0x7c, 0x73, 0xe2, 0x17, 0xf8, 0x5a, 0xda, 0x17, 0x67, 0x5b, 0xe2, 0x39, 0x8f, 0xf8, 0x99, 0xfa,
+	0xf1, 0x03, 0xb8, 0x75, 0xd6, 0x03, 0xec, 0x6e, 0x1b, 0x51, 0x6c, 0x07, 0x3d, 0x26, 0xc7, 0x9d,
+	0x33, 0xc2, 0x62, 0xeb, 0xfd, 0xc8, 0x78, 0x6d, 0x98, 0xc3, 0xe4, 0xd0, 0x4d, 0xeb, 0xc6, 0x19,
+	0xff, 0xd9, 0xe7, 0xbb, 0xef, 0xf6, 0xd8, 0xd9, 0xd1, 0x66, 0x63, 0xfb, 0xd3, 0x67, 0xab, 0xda,
+	0xd3, 0x67, 0xab, 0xda, 0xdf, 0x9e, 0xad, 0x6a, 0x1f, 0x3d, 0x5f, 0x9d, 0x7a, 0xfa, 0x7c, 0x75,
+	0xea, 0xcf, 0xcf, 0x57, 0xa7, 0xbe, 0xf3, 0xfa, 0x67, 0xde, 0x2f, 0xf7, 0x8f, 0x1d, 0x87, 0x65,
+	0x11, 0x24, 0x6f, 0xfd, 0x67, 0x00, 0x43, 0x1b, 0x97, 0x95, 0xf2, 0x21, 0x00, 0x00,
 }
 
 func (this *HistoricalInfo) Equal(that interface{}) bool {
@@ -1682,6 +1813,12 @@ func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	if !this.Balance.Equal(that1.Balance) {
 		return false
 	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntry) Equal(that interface{}) bool {
@@ -1715,6 +1852,12 @@ func (this *RedelegationEntry) Equal(that interface{}) bool {
 	if !this.SharesDst.Equal(that1.SharesDst) {
 		return false
 	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
 	return true
 }
 func (this *Redelegation) Equal(that interface{}) bool {
@@ -1789,6 +1932,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.MaxRedelegationSharesPerValidator.Equal(that1.MaxRedelegationSharesPerValidator) {
+		return false
+	}
+	if !this.MinDelegation.Equal(that1.MinDelegation) {
+		return false
+	}
+	if this.MaxValidatorsPhaseOutRate != that1.MaxValidatorsPhaseOutRate {
+		return false
+	}
 	return true
 }
 func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
@@ -2087,6 +2239,50 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEditDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewDelegatorAddress) > 0 {
+		i -= len(m.NewDelegatorAddress)
+		copy(dAtA[i:], m.NewDelegatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewDelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2674,6 +2870,16 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.Balance.Size()
 		i -= size
@@ -2730,6 +2936,16 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.SharesDst.Size()
 		i -= size
@@ -2844,6 +3060,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxValidatorsPhaseOutRate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxValidatorsPhaseOutRate))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxRedelegationSharesPerValidator.Size()
+		i -= size
+		if _, err := m.MaxRedelegationSharesPerValidator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3001,6 +3242,27 @@ func (m *MsgUndelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgEditDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewDelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3238,6 +3500,12 @@ func (m *UnbondingDelegationEntry) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
@@ -3256,6 +3524,12 @@ func (m *RedelegationEntry) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.SharesDst.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
@@ -3307,6 +3581,13 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.MaxRedelegationSharesPerValidator.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaxValidatorsPhaseOutRate != 0 {
+		n += 1 + sovTypes(uint64(m.MaxValidatorsPhaseOutRate))
+	}
 	return n
 }
 
@@ -4290,6 +4571,161 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEditDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDelegatorAddress = append(m.NewDelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewDelegatorAddress == nil {
+				m.NewDelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6184,6 +6620,44 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6357,6 +6831,44 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6721,6 +7233,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationSharesPerValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedelegationSharesPerValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsPhaseOutRate", wireType)
+			}
+			m.MaxValidatorsPhaseOutRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorsPhaseOutRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
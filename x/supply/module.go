@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/supply/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/supply/client/rest"
@@ -127,6 +129,13 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	return []abci.ValidatorUpdate{}
 }
 
+// GenesisDependencies returns the bank and gov modules, since the total supply
+// defaults to the sum of the balances, including the deposits the gov module
+// sets as the balance of its module account.
+func (AppModule) GenesisDependencies() []string {
+	return []string{bank.ModuleName, govtypes.ModuleName}
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the supply
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {